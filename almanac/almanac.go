@@ -0,0 +1,111 @@
+package almanac
+
+import (
+	"math"
+	"time"
+)
+
+// Easter liefert den Ostersonntag eines Jahres nach der gregorianischen Osterformel (erster
+// Sonntag nach dem ersten Frühlingsvollmond) in der Zeitzone loc
+func Easter(year int, loc *time.Location) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+}
+
+// Season ist der Beginn einer astronomischen Jahreszeit
+type Season int
+
+const (
+	MarchEquinox     Season = iota // Frühlingsanfang auf der Nordhalbkugel
+	JuneSolstice                   // Sommeranfang auf der Nordhalbkugel
+	SeptemberEquinox               // Herbstanfang auf der Nordhalbkugel
+	DecemberSolstice               // Winteranfang auf der Nordhalbkugel
+)
+
+// Mittlere Zeitpunkte der Tagundnachtgleichen und Sonnenwenden als julianisches Datum für die
+// Jahre 1000 bis 3000 (Meeus, Astronomical Algorithms, Tabelle 27.B)
+var seasonTerms = [4][5]float64{
+	{2451623.80984, 365242.37404, 0.05169, -0.00411, -0.00057},
+	{2451716.56767, 365241.62603, 0.00325, 0.00888, -0.00030},
+	{2451810.21715, 365242.01767, -0.11575, 0.00337, 0.00078},
+	{2451900.05952, 365242.74049, -0.06223, -0.00823, 0.00032},
+}
+
+// Periodische Korrekturen (Meeus, Tabelle 27.C): Amplitude, Phase und Frequenz in Grad
+var seasonCorrections = [24][3]float64{
+	{485, 324.96, 1934.136}, {203, 337.23, 32964.467}, {199, 342.08, 20.186}, {182, 27.85, 445267.112},
+	{156, 73.14, 45036.886}, {136, 171.52, 22518.443}, {77, 222.54, 65928.934}, {74, 296.72, 3034.906},
+	{70, 243.58, 9037.513}, {58, 119.81, 33718.147}, {52, 297.17, 150.678}, {50, 21.02, 2281.226},
+	{45, 247.54, 29929.562}, {44, 325.15, 31555.956}, {29, 60.93, 4443.417}, {18, 155.12, 67555.328},
+	{17, 288.79, 4562.452}, {16, 198.04, 62894.029}, {14, 199.76, 31436.921}, {12, 95.39, 14577.848},
+	{12, 287.11, 31931.756}, {12, 320.81, 34777.259}, {9, 227.73, 1222.114}, {8, 15.45, 16859.074},
+}
+
+// SeasonStart liefert den Zeitpunkt einer Tagundnachtgleiche oder Sonnenwende in der Zeitzone
+// loc. Die Genauigkeit liegt bei wenigen Minuten, der Unterschied zwischen Erdzeit und
+// Weltzeit (etwa eine Minute) wird vernachlässigt.
+func SeasonStart(year int, season Season, loc *time.Location) time.Time {
+	terms := seasonTerms[season]
+	y := float64(year-2000) / 1000
+	jde0 := terms[0] + terms[1]*y + terms[2]*y*y + terms[3]*y*y*y + terms[4]*y*y*y*y
+
+	t := (jde0 - 2451545.0) / 36525
+	w := radians(35999.373*t - 2.47)
+	deltaLambda := 1 + 0.0334*math.Cos(w) + 0.0007*math.Cos(2*w)
+	s := 0.0
+	for _, correction := range seasonCorrections {
+		s += correction[0] * math.Cos(radians(correction[1]+correction[2]*t))
+	}
+	jde := jde0 + 0.00001*s/deltaLambda
+
+	// Julianisches Datum 2440587.5 ist der 1. Januar 1970, 0 Uhr
+	seconds := math.Round((jde - 2440587.5) * 24 * 60 * 60)
+	return time.Unix(int64(seconds), 0).In(loc)
+}
+
+func radians(degrees float64) float64 {
+	return degrees * math.Pi / 180
+}
+
+// Transition ist eine Zeitumstellung
+type Transition struct {
+	At        time.Time // Zeitpunkt der Umstellung in der Zeitzone
+	OldOffset int       // Abweichung von UTC in Sekunden vor der Umstellung
+	NewOffset int       // Abweichung von UTC in Sekunden nach der Umstellung
+}
+
+// Forward gibt an, ob die Uhren vorgestellt werden (Beginn der Sommerzeit)
+func (t Transition) Forward() bool {
+	return t.NewOffset > t.OldOffset
+}
+
+// Transitions liefert die Zeitumstellungen der Zeitzone von t bis until
+func Transitions(t, until time.Time) []Transition {
+	var transitions []Transition
+	for {
+		_, end := t.ZoneBounds()
+		if end.IsZero() || end.After(until) {
+			return transitions
+		}
+		_, oldOffset := t.Zone()
+		_, newOffset := end.Zone()
+		// Auch Wechsel nur des Namens der Zone beenden einen Abschnitt
+		if newOffset != oldOffset {
+			transitions = append(transitions, Transition{At: end, OldOffset: oldOffset, NewOffset: newOffset})
+		}
+		t = end
+	}
+}
@@ -0,0 +1,72 @@
+package almanac
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEaster(t *testing.T) {
+	tests := map[int]string{
+		2024: "2024-03-31",
+		2025: "2025-04-20",
+		2026: "2026-04-05",
+		// Frühester und spätester möglicher Ostersonntag
+		2285: "2285-03-22",
+		2038: "2038-04-25",
+	}
+	for year, want := range tests {
+		if got := Easter(year, time.UTC).Format("2006-01-02"); got != want {
+			t.Errorf("Easter(%d) = %s, want %s", year, got, want)
+		}
+	}
+}
+
+func TestSeasonStart(t *testing.T) {
+	tests := []struct {
+		year   int
+		season Season
+		want   time.Time
+	}{
+		{2025, MarchEquinox, time.Date(2025, time.March, 20, 9, 1, 0, 0, time.UTC)},
+		{2025, JuneSolstice, time.Date(2025, time.June, 21, 2, 42, 0, 0, time.UTC)},
+		{2025, SeptemberEquinox, time.Date(2025, time.September, 22, 18, 19, 0, 0, time.UTC)},
+		{2025, DecemberSolstice, time.Date(2025, time.December, 21, 15, 3, 0, 0, time.UTC)},
+	}
+	for _, tt := range tests {
+		got := SeasonStart(tt.year, tt.season, time.UTC)
+		if diff := got.Sub(tt.want).Abs(); diff > 5*time.Minute {
+			t.Errorf("SeasonStart(%d, %d) = %s, want %s", tt.year, tt.season, got.Format(time.RFC3339), tt.want.Format(time.RFC3339))
+		}
+	}
+
+	// Der Zeitpunkt wird in der Zeitzone geliefert
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone data not available")
+	}
+	if got := SeasonStart(2025, JuneSolstice, berlin); got.Location() != berlin || got.Hour() != 4 {
+		t.Errorf("SeasonStart in Berlin = %s", got)
+	}
+}
+
+func TestTransitions(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone data not available")
+	}
+	from := time.Date(2025, time.January, 1, 0, 0, 0, 0, berlin)
+	transitions := Transitions(from, from.AddDate(1, 0, 0))
+	if len(transitions) != 2 {
+		t.Fatalf("got %d transitions, want 2", len(transitions))
+	}
+	if got := transitions[0]; !got.Forward() || !got.At.Equal(time.Date(2025, time.March, 30, 1, 0, 0, 0, time.UTC)) {
+		t.Errorf("first transition = %+v", got)
+	}
+	if got := transitions[1]; got.Forward() || !got.At.Equal(time.Date(2025, time.October, 26, 1, 0, 0, 0, time.UTC)) {
+		t.Errorf("second transition = %+v", got)
+	}
+
+	if got := Transitions(time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)); got != nil {
+		t.Errorf("UTC has transitions %+v", got)
+	}
+}
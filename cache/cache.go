@@ -1,6 +1,7 @@
 package cache
 
 import (
+	"crypto/sha256"
 	"fmt"
 	"net/url"
 	"os"
@@ -12,9 +13,13 @@ import (
 
 const cacheDir = "/app/.cache"
 
-func GenerateCacheFileName(date time.Time, version string, excludedCategories []string, name string, includeEmoji bool, format string) string {
+// GenerateCacheFileName erzeugt den Pfad der Cache-Datei. Über extra können weitere
+// Bestandteile für den Fingerabdruck übergeben werden, z.B. das Abrufdatum bei Feeds.
+// Datum, Version und Format bleiben lesbar, alle übrigen Bestandteile gehen als SHA-256 in
+// den Namen ein, damit er auch bei vielen Optionen unter der Längengrenze für Dateinamen bleibt.
+func GenerateCacheFileName(date time.Time, version string, excludedCategories []string, name string, includeEmoji bool, format string, extra ...string) string {
 	dateStr := date.Format("2006-01-02")
-	fingerprint := []string{dateStr, version, format}
+	var fingerprint []string
 	if len(excludedCategories) > 0 {
 		fingerprint = append(fingerprint, strings.Join(excludedCategories[:], "_"))
 	}
@@ -24,7 +29,9 @@ func GenerateCacheFileName(date time.Time, version string, excludedCategories []
 	if includeEmoji {
 		fingerprint = append(fingerprint, "emoji")
 	}
-	return filepath.Join(cacheDir, fmt.Sprintf("results_%s.json", strings.Join(fingerprint[:], "_")))
+	fingerprint = append(fingerprint, extra...)
+	hash := sha256.Sum256([]byte(strings.Join(fingerprint, "\n")))
+	return filepath.Join(cacheDir, fmt.Sprintf("results_%s_%s_%s_%x.json", dateStr, version, format, hash))
 }
 
 func CreateCacheDir() error {
@@ -0,0 +1,112 @@
+package calendars
+
+import (
+	"fmt"
+	"time"
+)
+
+// Date ist ein Datum in einem anderen Kalendersystem. Die Monate werden ab 1 gezählt, Leap
+// kennzeichnet einen Schaltmonat im chinesischen Kalender.
+type Date struct {
+	Year  int
+	Month int
+	Day   int
+	Leap  bool
+}
+
+// Calendar rechnet zwischen einem Kalendersystem und dem gregorianischen Kalender um. Alle
+// Kalender zählen Tage von Mitternacht bis Mitternacht, auch wenn der Tag traditionell mit dem
+// Sonnenuntergang beginnt.
+type Calendar interface {
+	// Name liefert den Namen des Kalenders als Adjektiv, z.B. "hebräisch"
+	Name() string
+	// FromTime liefert das Datum des Kalendertags von t. ok ist false außerhalb des
+	// unterstützten Bereichs.
+	FromTime(t time.Time) (date Date, ok bool)
+	// ToTime liefert den gregorianischen Tag eines Datums in der Zeitzone loc
+	ToTime(date Date, loc *time.Location) (t time.Time, ok bool)
+	// Anniversary liefert den Jahrestag von birth im Jahr year. Gibt es den Tag in diesem
+	// Jahr nicht, wird er nach den Regeln des Kalenders ersetzt.
+	Anniversary(birth Date, year int) Date
+	// Format schreibt ein Datum mit deutschem Monatsnamen, z.B. "3. Tischri 5785"
+	Format(date Date) string
+}
+
+// Unterstützte Kalender in der Reihenfolge der Dokumentation
+var calendars = []struct {
+	id       string
+	calendar Calendar
+}{
+	{"hebrew", Hebrew{}},
+	{"islamic", Islamic{}},
+	{"chinese", Chinese{}},
+	{"persian", Persian{}},
+}
+
+// IDs liefert die Kürzel aller unterstützten Kalender
+func IDs() []string {
+	ids := make([]string, 0, len(calendars))
+	for _, entry := range calendars {
+		ids = append(ids, entry.id)
+	}
+	return ids
+}
+
+// Get liefert den Kalender zu einem Kürzel
+func Get(id string) (Calendar, bool) {
+	for _, entry := range calendars {
+		if entry.id == id {
+			return entry.calendar, true
+		}
+	}
+	return nil, false
+}
+
+// Tag 1 der Zählung (R.D. 1) ist der 1. Januar 1 im proleptischen gregorianischen Kalender,
+// der 1. Januar 1970 ist Tag 719163
+const unixEpochFixed = 719163
+
+// fixedFromTime liefert die fortlaufende Tagesnummer des Kalendertags von t
+func fixedFromTime(t time.Time) int {
+	date := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	return int(date.Unix()/(24*60*60)) + unixEpochFixed
+}
+
+// fixedFromGregorian liefert die fortlaufende Tagesnummer eines gregorianischen Datums
+func fixedFromGregorian(year int, month time.Month, day int) int {
+	return fixedFromTime(time.Date(year, month, day, 0, 0, 0, 0, time.UTC))
+}
+
+// timeFromFixed liefert den Kalendertag zu einer fortlaufenden Tagesnummer in der Zeitzone loc
+func timeFromFixed(fixed int, loc *time.Location) time.Time {
+	return time.Date(1970, time.January, 1+fixed-unixEpochFixed, 0, 0, 0, 0, loc)
+}
+
+// floorDiv teilt ganzzahlig und rundet dabei ab, auch bei negativen Zahlen
+func floorDiv(a, b int) int {
+	q := a / b
+	if (a%b != 0) && ((a < 0) != (b < 0)) {
+		q--
+	}
+	return q
+}
+
+// mod liefert den nicht negativen Rest der Division
+func mod(a, b int) int {
+	return a - b*floorDiv(a, b)
+}
+
+// clampDay begrenzt den Tag auf die Länge des Monats
+func clampDay(date Date, length int) Date {
+	date.Day = min(date.Day, length)
+	return date
+}
+
+// formatDay schreibt Tag, Monatsnamen und Jahr, z.B. "3. Tischri 5785"
+func formatDay(date Date, monthName, suffix string) string {
+	text := fmt.Sprintf("%d. %s %d", date.Day, monthName, date.Year)
+	if suffix != "" {
+		text += " " + suffix
+	}
+	return text
+}
@@ -0,0 +1,111 @@
+package calendars
+
+import (
+	"testing"
+	"time"
+)
+
+func TestKnownDates(t *testing.T) {
+	tests := []struct {
+		calendar string
+		date     Date
+		want     string
+	}{
+		// Rosch ha-Schana 5785 und der erste Tag von Pessach
+		{"hebrew", Date{Year: 5785, Month: 7, Day: 1}, "2024-10-03"},
+		{"hebrew", Date{Year: 5785, Month: 1, Day: 15}, "2025-04-13"},
+		// Purim im Schaltjahr 5784 liegt im Adar II
+		{"hebrew", Date{Year: 5784, Month: 13, Day: 14}, "2024-03-24"},
+		// Nowruz
+		{"persian", Date{Year: 1403, Month: 1, Day: 1}, "2024-03-20"},
+		{"persian", Date{Year: 1404, Month: 1, Day: 1}, "2025-03-21"},
+		{"persian", Date{Year: 1403, Month: 12, Day: 30}, "2025-03-20"},
+		// Neujahrsfest und der Schaltmonat nach dem 2. Monat 2023
+		{"chinese", Date{Year: 2024, Month: 1, Day: 1}, "2024-02-10"},
+		{"chinese", Date{Year: 2025, Month: 1, Day: 1}, "2025-01-29"},
+		{"chinese", Date{Year: 2023, Month: 2, Day: 1, Leap: true}, "2023-03-22"},
+		// Tabellarischer Kalender: das islamische Neujahr 1446 lag nach Umm al-Qura einen Tag früher
+		{"islamic", Date{Year: 1446, Month: 1, Day: 1}, "2024-07-08"},
+		{"islamic", Date{Year: 1446, Month: 9, Day: 1}, "2025-03-01"},
+	}
+	for _, tt := range tests {
+		calendar, ok := Get(tt.calendar)
+		if !ok {
+			t.Fatalf("Get(%q) failed", tt.calendar)
+		}
+		got, ok := calendar.ToTime(tt.date, time.UTC)
+		if !ok || got.Format("2006-01-02") != tt.want {
+			t.Errorf("%s ToTime(%+v) = %s, %v, want %s", tt.calendar, tt.date, got.Format("2006-01-02"), ok, tt.want)
+		}
+		want, _ := time.Parse("2006-01-02", tt.want)
+		if back, ok := calendar.FromTime(want); !ok || back != tt.date {
+			t.Errorf("%s FromTime(%s) = %+v, %v, want %+v", tt.calendar, tt.want, back, ok, tt.date)
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, id := range IDs() {
+		calendar, _ := Get(id)
+		previous := Date{}
+		for day := time.Date(1950, time.January, 1, 0, 0, 0, 0, time.UTC); day.Year() < 2090; day = day.AddDate(0, 0, 1) {
+			date, ok := calendar.FromTime(day)
+			if !ok {
+				t.Fatalf("%s FromTime(%s) not supported", id, day.Format("2006-01-02"))
+			}
+			back, ok := calendar.ToTime(date, time.UTC)
+			if !ok || !back.Equal(day) {
+				t.Fatalf("%s %s -> %+v -> %s", id, day.Format("2006-01-02"), date, back.Format("2006-01-02"))
+			}
+			// Aufeinanderfolgende Tage zählen den Tag weiter oder beginnen einen neuen Monat
+			if previous.Year != 0 && date.Day != previous.Day+1 && date.Day != 1 {
+				t.Fatalf("%s %s: %+v follows %+v", id, day.Format("2006-01-02"), date, previous)
+			}
+			previous = date
+		}
+	}
+}
+
+func TestAnniversary(t *testing.T) {
+	tests := []struct {
+		calendar string
+		birth    Date
+		year     int
+		want     Date
+	}{
+		// Adar eines Gemeinjahrs wird im Schaltjahr zum Adar II und umgekehrt
+		{"hebrew", Date{Year: 5783, Month: 12, Day: 10}, 5784, Date{Year: 5784, Month: 13, Day: 10}},
+		{"hebrew", Date{Year: 5784, Month: 13, Day: 10}, 5785, Date{Year: 5785, Month: 12, Day: 10}},
+		{"hebrew", Date{Year: 5784, Month: 12, Day: 10}, 5785, Date{Year: 5785, Month: 12, Day: 10}},
+		// Der 30. Esfand gibt es nur in Schaltjahren
+		{"persian", Date{Year: 1403, Month: 12, Day: 30}, 1404, Date{Year: 1404, Month: 12, Day: 29}},
+		// Geburtstage im Schaltmonat zählen ohne Schaltmonat im regulären Monat
+		{"chinese", Date{Year: 2023, Month: 2, Day: 5, Leap: true}, 2024, Date{Year: 2024, Month: 2, Day: 5}},
+		{"islamic", Date{Year: 1445, Month: 3, Day: 12}, 1446, Date{Year: 1446, Month: 3, Day: 12}},
+	}
+	for _, tt := range tests {
+		calendar, _ := Get(tt.calendar)
+		if got := calendar.Anniversary(tt.birth, tt.year); got != tt.want {
+			t.Errorf("%s Anniversary(%+v, %d) = %+v, want %+v", tt.calendar, tt.birth, tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestFormat(t *testing.T) {
+	tests := []struct {
+		calendar string
+		date     Date
+		want     string
+	}{
+		{"hebrew", Date{Year: 5785, Month: 7, Day: 3}, "3. Tischri 5785"},
+		{"persian", Date{Year: 1404, Month: 1, Day: 1}, "1. Farwardin 1404"},
+		{"chinese", Date{Year: 2025, Month: 1, Day: 1}, "1. Tag des 1. Monats im Jahr der Schlange 2025"},
+		{"chinese", Date{Year: 2023, Month: 2, Day: 5, Leap: true}, "5. Tag des 2. Schaltmonats im Jahr des Hasen 2023"},
+	}
+	for _, tt := range tests {
+		calendar, _ := Get(tt.calendar)
+		if got := calendar.Format(tt.date); got != tt.want {
+			t.Errorf("%s Format(%+v) = %q, want %q", tt.calendar, tt.date, got, tt.want)
+		}
+	}
+}
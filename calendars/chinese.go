@@ -0,0 +1,153 @@
+package calendars
+
+import (
+	"fmt"
+	"time"
+)
+
+// Chinese ist der chinesische Lunisolarkalender für die Jahre 1900 bis 2100. Das Jahr eines
+// Datums ist das gregorianische Jahr, in dem das Mondjahr mit dem Neujahrsfest beginnt.
+type Chinese struct{}
+
+// Erstes und letztes unterstütztes Jahr
+const (
+	chineseFirstYear = 1900
+	chineseLastYear  = 2100
+)
+
+// Neujahr des ersten unterstützten Jahres (31. Januar 1900)
+var chineseEpoch = fixedFromGregorian(chineseFirstYear, time.January, 31)
+
+// Monatslängen der Jahre 1900 bis 2100: Bit 15 bis 4 stehen für die Monate 1 bis 12 (gesetzt
+// bedeutet 30 statt 29 Tage), Bit 3 bis 0 für den Monat, auf den ein Schaltmonat folgt, und
+// Bit 16 für die Länge des Schaltmonats
+var chineseYears = []int{
+	0x04bd8, 0x04ae0, 0x0a570, 0x054d5, 0x0d260, 0x0d950, 0x16554, 0x056a0, 0x09ad0, 0x055d2, // 1900
+	0x04ae0, 0x0a5b6, 0x0a4d0, 0x0d250, 0x1d255, 0x0b540, 0x0d6a0, 0x0ada2, 0x095b0, 0x14977, // 1910
+	0x04970, 0x0a4b0, 0x0b4b5, 0x06a50, 0x06d40, 0x1ab54, 0x02b60, 0x09570, 0x052f2, 0x04970, // 1920
+	0x06566, 0x0d4a0, 0x0ea50, 0x16a95, 0x05ad0, 0x02b60, 0x186e3, 0x092e0, 0x1c8d7, 0x0c950, // 1930
+	0x0d4a0, 0x1d8a6, 0x0b550, 0x056a0, 0x1a5b4, 0x025d0, 0x092d0, 0x0d2b2, 0x0a950, 0x0b557, // 1940
+	0x06ca0, 0x0b550, 0x15355, 0x04da0, 0x0a5b0, 0x14573, 0x052b0, 0x0a9a8, 0x0e950, 0x06aa0, // 1950
+	0x0aea6, 0x0ab50, 0x04b60, 0x0aae4, 0x0a570, 0x05260, 0x0f263, 0x0d950, 0x05b57, 0x056a0, // 1960
+	0x096d0, 0x04dd5, 0x04ad0, 0x0a4d0, 0x0d4d4, 0x0d250, 0x0d558, 0x0b540, 0x0b6a0, 0x195a6, // 1970
+	0x095b0, 0x049b0, 0x0a974, 0x0a4b0, 0x0b27a, 0x06a50, 0x06d40, 0x0af46, 0x0ab60, 0x09570, // 1980
+	0x04af5, 0x04970, 0x064b0, 0x074a3, 0x0ea50, 0x06b58, 0x05ac0, 0x0ab60, 0x096d5, 0x092e0, // 1990
+	0x0c960, 0x0d954, 0x0d4a0, 0x0da50, 0x07552, 0x056a0, 0x0abb7, 0x025d0, 0x092d0, 0x0cab5, // 2000
+	0x0a950, 0x0b4a0, 0x0baa4, 0x0ad50, 0x055d9, 0x04ba0, 0x0a5b0, 0x15176, 0x052b0, 0x0a930, // 2010
+	0x07954, 0x06aa0, 0x0ad50, 0x05b52, 0x04b60, 0x0a6e6, 0x0a4e0, 0x0d260, 0x0ea65, 0x0d530, // 2020
+	0x05aa0, 0x076a3, 0x096d0, 0x04afb, 0x04ad0, 0x0a4d0, 0x1d0b6, 0x0d250, 0x0d520, 0x0dd45, // 2030
+	0x0b5a0, 0x056d0, 0x055b2, 0x049b0, 0x0a577, 0x0a4b0, 0x0aa50, 0x1b255, 0x06d20, 0x0ada0, // 2040
+	0x14b63, 0x09370, 0x049f8, 0x04970, 0x064b0, 0x168a6, 0x0ea50, 0x06b20, 0x1a6c4, 0x0aae0, // 2050
+	0x092e0, 0x0d2e3, 0x0c960, 0x0d557, 0x0d4a0, 0x0da50, 0x05d55, 0x056a0, 0x0a6d0, 0x055d4, // 2060
+	0x052d0, 0x0a9b8, 0x0a950, 0x0b4a0, 0x0b6a6, 0x0ad50, 0x055a0, 0x0aba4, 0x0a5b0, 0x052b0, // 2070
+	0x0b273, 0x06930, 0x07337, 0x06aa0, 0x0ad50, 0x14b55, 0x04b60, 0x0a570, 0x054e4, 0x0d160, // 2080
+	0x0e968, 0x0d520, 0x0daa0, 0x16aa6, 0x056d0, 0x04ae0, 0x0a9d4, 0x0a2d0, 0x0d150, 0x0f252, // 2090
+	0x0d520, // 2100
+}
+
+// Tierkreiszeichen im Genitiv, beginnend mit dem Jahr der Ratte (z.B. 2020)
+var chineseAnimals = []string{"der Ratte", "des Büffels", "des Tigers", "des Hasen", "des Drachen", "der Schlange", "des Pferdes", "der Ziege", "des Affen", "des Hahns", "des Hundes", "des Schweins"}
+
+// chineseLeapMonth liefert den Monat, auf den im Jahr ein Schaltmonat folgt, oder 0
+func chineseLeapMonth(year int) int {
+	return chineseYears[year-chineseFirstYear] & 0xf
+}
+
+// chineseMonthLength liefert die Länge eines Monats oder des Schaltmonats nach ihm
+func chineseMonthLength(year, month int, leap bool) int {
+	info := chineseYears[year-chineseFirstYear]
+	if leap {
+		if info&0x10000 != 0 {
+			return 30
+		}
+		return 29
+	}
+	if info&(0x10000>>month) != 0 {
+		return 30
+	}
+	return 29
+}
+
+// chineseMonths liefert die Monate eines Jahres in ihrer Reihenfolge mit Schaltmonat
+func chineseMonths(year int) []Date {
+	leapMonth := chineseLeapMonth(year)
+	months := make([]Date, 0, 13)
+	for month := 1; month <= 12; month++ {
+		months = append(months, Date{Year: year, Month: month, Day: 1})
+		if month == leapMonth {
+			months = append(months, Date{Year: year, Month: month, Day: 1, Leap: true})
+		}
+	}
+	return months
+}
+
+// chineseYearLength liefert die Anzahl der Tage eines Jahres
+func chineseYearLength(year int) int {
+	days := 0
+	for _, month := range chineseMonths(year) {
+		days += chineseMonthLength(year, month.Month, month.Leap)
+	}
+	return days
+}
+
+func (Chinese) Name() string {
+	return "chinesisch"
+}
+
+func (Chinese) FromTime(t time.Time) (Date, bool) {
+	days := fixedFromTime(t) - chineseEpoch
+	if days < 0 {
+		return Date{}, false
+	}
+	for year := chineseFirstYear; year <= chineseLastYear; year++ {
+		if length := chineseYearLength(year); days >= length {
+			days -= length
+			continue
+		}
+		for _, month := range chineseMonths(year) {
+			length := chineseMonthLength(year, month.Month, month.Leap)
+			if days < length {
+				month.Day = days + 1
+				return month, true
+			}
+			days -= length
+		}
+	}
+	return Date{}, false
+}
+
+func (Chinese) ToTime(date Date, loc *time.Location) (time.Time, bool) {
+	if date.Year < chineseFirstYear || date.Year > chineseLastYear {
+		return time.Time{}, false
+	}
+	fixed := chineseEpoch
+	for year := chineseFirstYear; year < date.Year; year++ {
+		fixed += chineseYearLength(year)
+	}
+	for _, month := range chineseMonths(date.Year) {
+		if month.Month == date.Month && month.Leap == date.Leap {
+			return timeFromFixed(fixed+date.Day-1, loc), true
+		}
+		fixed += chineseMonthLength(date.Year, month.Month, month.Leap)
+	}
+	return time.Time{}, false
+}
+
+// Anniversary feiert Geburtstage aus einem Schaltmonat in Jahren ohne diesen Schaltmonat im
+// regulären Monat gleicher Nummer. Fehlt der 30. eines Monats, zählt der 29.
+func (Chinese) Anniversary(birth Date, year int) Date {
+	date := Date{Year: year, Month: birth.Month, Day: birth.Day}
+	if year < chineseFirstYear || year > chineseLastYear {
+		return date
+	}
+	date.Leap = birth.Leap && chineseLeapMonth(year) == birth.Month
+	return clampDay(date, chineseMonthLength(year, date.Month, date.Leap))
+}
+
+func (Chinese) Format(date Date) string {
+	month := fmt.Sprintf("%d. Monats", date.Month)
+	if date.Leap {
+		month = fmt.Sprintf("%d. Schaltmonats", date.Month)
+	}
+	return fmt.Sprintf("%d. Tag des %s im Jahr %s %d", date.Day, month, chineseAnimals[mod(date.Year-2020, 12)], date.Year)
+}
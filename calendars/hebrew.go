@@ -0,0 +1,150 @@
+package calendars
+
+import "time"
+
+// Hebrew ist der hebräische (jüdische) Lunisolarkalender nach den arithmetischen Regeln des
+// festen Kalenders. Die Monate werden wie üblich ab Nisan gezählt, das Jahr beginnt mit Tischri
+// (Monat 7). In Schaltjahren ist Monat 12 Adar I und Monat 13 Adar II.
+type Hebrew struct{}
+
+// Tag 1 der Zählung, an dem der 1. Tischri des Jahres 1 liegt
+const hebrewEpoch = -1373427
+
+var hebrewMonths = []string{"Nisan", "Ijjar", "Siwan", "Tammus", "Aw", "Elul", "Tischri", "Cheschwan", "Kislew", "Tewet", "Schwat", "Adar", "Adar II"}
+
+// hebrewLeapYear gibt an, ob das Jahr einen dreizehnten Monat hat (7 von 19 Jahren)
+func hebrewLeapYear(year int) bool {
+	return mod(7*year+1, 19) < 7
+}
+
+// hebrewLastMonth liefert die Nummer des letzten Monats im Jahr
+func hebrewLastMonth(year int) int {
+	if hebrewLeapYear(year) {
+		return 13
+	}
+	return 12
+}
+
+// hebrewElapsedDays liefert die Tage vom Beginn der Zählung bis zum Neujahr nach dem Molad,
+// verschoben, wenn das Neujahr sonst auf einen Sonntag, Mittwoch oder Freitag fiele
+func hebrewElapsedDays(year int) int {
+	monthsElapsed := floorDiv(235*year-234, 19)
+	partsElapsed := 12084 + 13753*monthsElapsed
+	days := 29*monthsElapsed + floorDiv(partsElapsed, 25920)
+	if mod(3*(days+1), 7) < 3 {
+		return days + 1
+	}
+	return days
+}
+
+// hebrewYearLengthCorrection verschiebt das Neujahr, damit kein Jahr eine unzulässige Länge hat
+func hebrewYearLengthCorrection(year int) int {
+	previous := hebrewElapsedDays(year - 1)
+	current := hebrewElapsedDays(year)
+	next := hebrewElapsedDays(year + 1)
+	switch {
+	case next-current == 356:
+		return 2
+	case current-previous == 382:
+		return 1
+	}
+	return 0
+}
+
+// hebrewNewYear liefert die Tagesnummer des 1. Tischri
+func hebrewNewYear(year int) int {
+	return hebrewEpoch + hebrewElapsedDays(year) + hebrewYearLengthCorrection(year)
+}
+
+// hebrewDaysInYear liefert die Länge des Jahres (353–355 oder 383–385 Tage)
+func hebrewDaysInYear(year int) int {
+	return hebrewNewYear(year+1) - hebrewNewYear(year)
+}
+
+// hebrewMonthLength liefert die Länge eines Monats. Cheschwan und Kislew haben je nach
+// Jahreslänge 29 oder 30 Tage.
+func hebrewMonthLength(year, month int) int {
+	days := hebrewDaysInYear(year)
+	switch {
+	case month == 2 || month == 4 || month == 6 || month == 10 || month == 13:
+		return 29
+	case month == 12 && !hebrewLeapYear(year):
+		return 29
+	case month == 8 && days%10 != 5:
+		return 29
+	case month == 9 && days%10 == 3:
+		return 29
+	}
+	return 30
+}
+
+// fixedFromHebrew liefert die Tagesnummer eines hebräischen Datums
+func fixedFromHebrew(date Date) int {
+	fixed := hebrewNewYear(date.Year) + date.Day - 1
+	if date.Month < 7 {
+		for month := 7; month <= hebrewLastMonth(date.Year); month++ {
+			fixed += hebrewMonthLength(date.Year, month)
+		}
+		for month := 1; month < date.Month; month++ {
+			fixed += hebrewMonthLength(date.Year, month)
+		}
+	} else {
+		for month := 7; month < date.Month; month++ {
+			fixed += hebrewMonthLength(date.Year, month)
+		}
+	}
+	return fixed
+}
+
+// hebrewFromFixed liefert das hebräische Datum zu einer Tagesnummer
+func hebrewFromFixed(fixed int) Date {
+	approx := floorDiv((fixed-hebrewEpoch)*98496, 35975351) + 1
+	// Das Jahr ist das letzte ab approx-1, dessen Neujahr nicht nach dem Tag liegt
+	year := approx - 1
+	for hebrewNewYear(year+1) <= fixed {
+		year++
+	}
+	month := 7
+	if fixed >= fixedFromHebrew(Date{Year: year, Month: 1, Day: 1}) {
+		month = 1
+	}
+	for fixed > fixedFromHebrew(Date{Year: year, Month: month, Day: hebrewMonthLength(year, month)}) {
+		month++
+	}
+	day := fixed - fixedFromHebrew(Date{Year: year, Month: month, Day: 1}) + 1
+	return Date{Year: year, Month: month, Day: day}
+}
+
+func (Hebrew) Name() string {
+	return "hebräisch"
+}
+
+func (Hebrew) FromTime(t time.Time) (Date, bool) {
+	return hebrewFromFixed(fixedFromTime(t)), true
+}
+
+func (Hebrew) ToTime(date Date, loc *time.Location) (time.Time, bool) {
+	return timeFromFixed(fixedFromHebrew(date), loc), true
+}
+
+// Anniversary folgt dem Brauch für Geburtstage: Wer im Adar eines Gemeinjahrs geboren ist,
+// feiert in Schaltjahren im Adar II, wer im Adar I oder II geboren ist, in Gemeinjahren im
+// Adar. Fehlt der 30. eines Monats, zählt der 29.
+func (Hebrew) Anniversary(birth Date, year int) Date {
+	date := Date{Year: year, Month: birth.Month, Day: birth.Day}
+	switch {
+	case birth.Month == 12 && !hebrewLeapYear(birth.Year) && hebrewLeapYear(year):
+		date.Month = 13
+	case birth.Month == 13 && !hebrewLeapYear(year):
+		date.Month = 12
+	}
+	return clampDay(date, hebrewMonthLength(year, date.Month))
+}
+
+func (Hebrew) Format(date Date) string {
+	name := hebrewMonths[date.Month-1]
+	if date.Month == 12 && hebrewLeapYear(date.Year) {
+		name = "Adar I"
+	}
+	return formatDay(date, name, "")
+}
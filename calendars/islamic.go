@@ -0,0 +1,66 @@
+package calendars
+
+import "time"
+
+// Islamic ist der tabellarische islamische Mondkalender mit 11 Schaltjahren in 30 Jahren. Der
+// beobachtete Kalender richtet sich nach der Sichtung der Mondsichel und kann um ein bis zwei
+// Tage abweichen.
+type Islamic struct{}
+
+// Tag 1 der Zählung, an dem der 1. Muharram des Jahres 1 liegt (16. Juli 622, julianisch)
+const islamicEpoch = 227015
+
+var islamicMonths = []string{"Muharram", "Safar", "Rabi al-awwal", "Rabi al-thani", "Dschumada al-ula", "Dschumada al-achira", "Radschab", "Schaban", "Ramadan", "Schawwal", "Dhu l-qada", "Dhu l-hiddscha"}
+
+// islamicLeapYear gibt an, ob der letzte Monat 30 statt 29 Tage hat
+func islamicLeapYear(year int) bool {
+	return mod(14+11*year, 30) < 11
+}
+
+// islamicMonthLength liefert die Länge eines Monats: ungerade Monate haben 30 Tage, gerade 29
+func islamicMonthLength(year, month int) int {
+	if month%2 == 1 || (month == 12 && islamicLeapYear(year)) {
+		return 30
+	}
+	return 29
+}
+
+// fixedFromIslamic liefert die Tagesnummer eines islamischen Datums
+func fixedFromIslamic(date Date) int {
+	return islamicEpoch - 1 + (date.Year-1)*354 + floorDiv(3+11*date.Year, 30) + 29*(date.Month-1) + date.Month/2 + date.Day
+}
+
+// islamicFromFixed liefert das islamische Datum zu einer Tagesnummer
+func islamicFromFixed(fixed int) Date {
+	year := floorDiv(30*(fixed-islamicEpoch)+10646, 10631)
+	priorDays := fixed - fixedFromIslamic(Date{Year: year, Month: 1, Day: 1})
+	month := floorDiv(11*priorDays+330, 325)
+	day := fixed - fixedFromIslamic(Date{Year: year, Month: month, Day: 1}) + 1
+	return Date{Year: year, Month: month, Day: day}
+}
+
+func (Islamic) Name() string {
+	return "islamisch"
+}
+
+func (Islamic) FromTime(t time.Time) (Date, bool) {
+	fixed := fixedFromTime(t)
+	if fixed < islamicEpoch {
+		return Date{}, false
+	}
+	return islamicFromFixed(fixed), true
+}
+
+func (Islamic) ToTime(date Date, loc *time.Location) (time.Time, bool) {
+	return timeFromFixed(fixedFromIslamic(date), loc), true
+}
+
+// Anniversary ersetzt den 30. Dhu l-hiddscha in Jahren ohne diesen Tag durch den 29.
+func (Islamic) Anniversary(birth Date, year int) Date {
+	date := Date{Year: year, Month: birth.Month, Day: birth.Day}
+	return clampDay(date, islamicMonthLength(year, date.Month))
+}
+
+func (Islamic) Format(date Date) string {
+	return formatDay(date, islamicMonths[date.Month-1], "n. H.")
+}
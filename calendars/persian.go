@@ -0,0 +1,117 @@
+package calendars
+
+import "time"
+
+// Persian ist der iranische Sonnenkalender (Solar Hidschri). Die Schaltjahre folgen dem
+// Verfahren von Borkowski, das für die Jahre -61 bis 3177 mit dem astronomischen Kalender
+// übereinstimmt.
+type Persian struct{}
+
+// Jahre, an denen sich der Schaltzyklus ändert
+var persianBreaks = []int{-61, 9, 38, 199, 426, 686, 756, 818, 1111, 1181, 1210, 1635, 2060, 2097, 2192, 2262, 2324, 2394, 2456, 3178}
+
+var persianMonths = []string{"Farwardin", "Ordibehescht", "Chordad", "Tir", "Mordad", "Schahriwar", "Mehr", "Aban", "Azar", "Dey", "Bahman", "Esfand"}
+
+// persianYearInfo liefert das gregorianische Jahr und den Tag im März, an dem das Jahr
+// beginnt, sowie die Jahre seit dem letzten Schaltjahr (0 bedeutet, das Jahr ist ein
+// Schaltjahr). ok ist false außerhalb des unterstützten Bereichs.
+func persianYearInfo(year int) (gregorianYear, march, leap int, ok bool) {
+	if year < persianBreaks[0] || year >= persianBreaks[len(persianBreaks)-1] {
+		return 0, 0, 0, false
+	}
+
+	gregorianYear = year + 621
+	leapYears := -14
+	previousBreak := persianBreaks[0]
+	jump := 0
+	for _, nextBreak := range persianBreaks[1:] {
+		jump = nextBreak - previousBreak
+		if year < nextBreak {
+			break
+		}
+		leapYears += jump/33*8 + jump%33/4
+		previousBreak = nextBreak
+	}
+	n := year - previousBreak
+
+	// Schaltjahre seit 621 im persischen und im gregorianischen Kalender
+	leapYears += n/33*8 + (n%33+3)/4
+	if jump%33 == 4 && jump-n == 4 {
+		leapYears++
+	}
+	gregorianLeapYears := gregorianYear/4 - (gregorianYear/100+1)*3/4 - 150
+	march = 20 + leapYears - gregorianLeapYears
+
+	if jump-n < 6 {
+		n = n - jump + (jump+4)/33*33
+	}
+	leap = ((n+1)%33 - 1) % 4
+	if leap == -1 {
+		leap = 4
+	}
+	return gregorianYear, march, leap, true
+}
+
+// persianMonthLength liefert die Länge eines Monats: 31 Tage in der ersten Jahreshälfte, dann
+// 30, der Esfand hat 29 Tage oder 30 in Schaltjahren
+func persianMonthLength(year, month int) int {
+	switch {
+	case month <= 6:
+		return 31
+	case month <= 11:
+		return 30
+	}
+	if _, _, leap, _ := persianYearInfo(year); leap == 0 {
+		return 30
+	}
+	return 29
+}
+
+func (Persian) Name() string {
+	return "persisch"
+}
+
+func (Persian) FromTime(t time.Time) (Date, bool) {
+	fixed := fixedFromTime(t)
+	year := t.Year() - 621
+	gregorianYear, march, leap, ok := persianYearInfo(year)
+	if !ok {
+		return Date{}, false
+	}
+
+	// Tage seit dem 1. Farwardin
+	days := fixed - fixedFromGregorian(gregorianYear, time.March, march)
+	if days >= 0 {
+		if days <= 185 {
+			return Date{Year: year, Month: 1 + days/31, Day: days%31 + 1}, true
+		}
+		days -= 186
+	} else {
+		// Der Tag liegt noch im vorherigen Jahr
+		year--
+		days += 179
+		if leap == 1 {
+			days++
+		}
+	}
+	return Date{Year: year, Month: 7 + days/30, Day: days%30 + 1}, true
+}
+
+func (Persian) ToTime(date Date, loc *time.Location) (time.Time, bool) {
+	gregorianYear, march, _, ok := persianYearInfo(date.Year)
+	if !ok {
+		return time.Time{}, false
+	}
+	fixed := fixedFromGregorian(gregorianYear, time.March, march) + (date.Month-1)*31 - date.Month/7*(date.Month-7) + date.Day - 1
+	return timeFromFixed(fixed, loc), true
+}
+
+// Anniversary ersetzt den 30. Esfand in Jahren ohne diesen Tag durch den 29.
+func (Persian) Anniversary(birth Date, year int) Date {
+	date := Date{Year: year, Month: birth.Month, Day: birth.Day}
+	return clampDay(date, persianMonthLength(year, date.Month))
+}
+
+func (Persian) Format(date Date) string {
+	return formatDay(date, persianMonths[date.Month-1], "")
+}
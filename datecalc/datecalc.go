@@ -0,0 +1,165 @@
+package datecalc
+
+import (
+	"fmt"
+	"time"
+)
+
+// MonthEndPolicy legt fest, auf welchen Tag Monats- und Jahresperioden fallen, wenn der
+// Geburtstag im Zielmonat nicht existiert (z.B. 31. Januar + 1 Monat)
+type MonthEndPolicy string
+
+const (
+	// MonthEndNormalize entspricht time.AddDate: der Überhang wird in den Folgemonat
+	// übertragen (31.01. + 1 Monat = 03.03. bzw. 02.03. in Schaltjahren)
+	MonthEndNormalize MonthEndPolicy = "normalize"
+	// MonthEndClamp begrenzt auf den letzten Tag des Zielmonats (31.01. + 1 Monat = 28.02.)
+	MonthEndClamp MonthEndPolicy = "clamp"
+	// MonthEndPrevious nimmt den Tag vor dem fehlenden Tag. Das ist der letzte vorhandene Tag
+	// des Zielmonats (31.01. + 1 Monat = 28.02., 31.03. + 1 Monat = 30.04.), Tage, die es im
+	// Zielmonat gibt, bleiben unverändert.
+	MonthEndPrevious MonthEndPolicy = "previous"
+)
+
+// LeapDayPolicy legt fest, auf welchen Tag Jahres- und Monatstage von Kindern fallen, die
+// am 29. Februar geboren sind, wenn es im Zieljahr keinen 29. Februar gibt
+type LeapDayPolicy string
+
+const (
+	// LeapDayDefault folgt der Monatsende-Regel (normalize: 1. März, clamp und previous: 28. Februar)
+	LeapDayDefault LeapDayPolicy = ""
+	// LeapDayFeb28 legt den Tag auf den 28. Februar
+	LeapDayFeb28 LeapDayPolicy = "feb28"
+	// LeapDayMar1 legt den Tag auf den 1. März
+	LeapDayMar1 LeapDayPolicy = "mar1"
+	// LeapDayOnly behält nur Tage, die auf einen echten 29. Februar fallen. Das Auslassen
+	// übernimmt der Aufrufer, die Datumsberechnung folgt der Monatsende-Regel.
+	LeapDayOnly LeapDayPolicy = "only"
+)
+
+// Policy fasst die Regeln für die Addition von Jahren und Monaten zusammen. Der Nullwert
+// entspricht time.AddDate.
+type Policy struct {
+	MonthEnd MonthEndPolicy
+	LeapDay  LeapDayPolicy
+}
+
+// ParseMonthEndPolicy liest die Regel aus einem Parameterwert. Ein leerer Wert ergibt
+// das bisherige Verhalten (normalize).
+func ParseMonthEndPolicy(value string) (MonthEndPolicy, error) {
+	switch MonthEndPolicy(value) {
+	case "", MonthEndNormalize:
+		return MonthEndNormalize, nil
+	case MonthEndClamp, MonthEndPrevious:
+		return MonthEndPolicy(value), nil
+	}
+	return "", fmt.Errorf("Invalid month-end value %q. Use normalize, clamp or previous.", value)
+}
+
+// ParseLeapDayPolicy liest die Regel für den 29. Februar aus einem Parameterwert
+func ParseLeapDayPolicy(value string) (LeapDayPolicy, error) {
+	switch LeapDayPolicy(value) {
+	case LeapDayDefault, LeapDayFeb28, LeapDayMar1, LeapDayOnly:
+		return LeapDayPolicy(value), nil
+	}
+	return "", fmt.Errorf("Invalid leap-day value %q. Use feb28, mar1 or only.", value)
+}
+
+// IsLeapYear prüft, ob ein Jahr ein Schaltjahr ist
+func IsLeapYear(year int) bool {
+	return DaysIn(year, time.February) == 29
+}
+
+// LeapDayAnniversary prüft, ob t ein 29. Februar ist und die Addition von Jahren und
+// Monaten in einen Februar führt. missing gibt an, ob es dort keinen 29. Februar gibt.
+func LeapDayAnniversary(t time.Time, years, months int) (anniversary bool, missing bool) {
+	if t.Month() != time.February || t.Day() != 29 || (years == 0 && months == 0) {
+		return false, false
+	}
+	target := time.Date(t.Year()+years, t.Month()+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	if target.Month() != time.February {
+		return false, false
+	}
+	return true, !IsLeapYear(target.Year())
+}
+
+// DaysIn liefert die Anzahl der Tage eines Monats
+func DaysIn(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
+
+// AddYearsMonths addiert Jahre und Monate nach den angegebenen Regeln. Uhrzeit und
+// Zeitzone von t bleiben erhalten.
+func AddYearsMonths(t time.Time, years, months int, policy Policy) time.Time {
+	if years == 0 && months == 0 {
+		return t
+	}
+
+	if _, missing := LeapDayAnniversary(t, years, months); missing {
+		feb28 := time.Date(t.Year()+years, t.Month()+time.Month(months), 28, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+		switch policy.LeapDay {
+		case LeapDayFeb28:
+			return feb28
+		case LeapDayMar1:
+			return feb28.AddDate(0, 0, 1)
+		}
+	}
+
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+
+	// Erster Tag des Zielmonats, time.Date normalisiert Monatswerte über 12
+	first := time.Date(year+years, month+time.Month(months), 1, hour, minute, second, t.Nanosecond(), t.Location())
+	lastDay := DaysIn(first.Year(), first.Month())
+
+	switch policy.MonthEnd {
+	case MonthEndClamp:
+		return first.AddDate(0, 0, min(day, lastDay)-1)
+	case MonthEndPrevious:
+		if day > lastDay {
+			// Der Tag vor dem ersten fehlenden Tag
+			return first.AddDate(0, 0, lastDay-1)
+		}
+		return first.AddDate(0, 0, day-1)
+	default:
+		return t.AddDate(years, months, 0)
+	}
+}
+
+// AddDeadline berechnet das Ende einer Frist von Jahren und Monaten nach § 188 Abs. 2 und 3 BGB,
+// wenn der erste Tag mitzählt (z.B. ein Lebensmonat ab der Geburt). Die Frist endet am Vortag
+// des entsprechenden Tags (15.01. + 1 Monat = 14.02.). Fehlt dieser Tag im Zielmonat, endet
+// sie am letzten Tag des Monats (31.01. + 1 Monat = 28.02.).
+func AddDeadline(t time.Time, years, months int) time.Time {
+	if years == 0 && months == 0 {
+		return t
+	}
+
+	year, month, day := t.Date()
+	hour, minute, second := t.Clock()
+	first := time.Date(year+years, month+time.Month(months), 1, hour, minute, second, t.Nanosecond(), t.Location())
+	if day == 1 {
+		// Der Vortag des Ersten ist der letzte Tag des Vormonats
+		return first.AddDate(0, 0, -1)
+	}
+	return first.AddDate(0, 0, min(day-1, DaysIn(first.Year(), first.Month()))-1)
+}
+
+// AddPeriod addiert eine Periode aus Jahren, Monaten, Wochen und Tagen. Jahre und Monate
+// werden nach den Regeln policy addiert, Wochen und Tage anschließend als feste Tage.
+func AddPeriod(t time.Time, years, months, weeks, days int, policy Policy) time.Time {
+	return AddYearsMonths(t, years, months, policy).AddDate(0, 0, days+weeks*7)
+}
+
+// CivilDays liefert die Anzahl der Kalendertage seit dem 01.01.1970 für das Datum von t in
+// seiner eigenen Zeitzone. Uhrzeit und Sommerzeitwechsel spielen dabei keine Rolle.
+func CivilDays(t time.Time) int {
+	year, month, day := t.Date()
+	return int(time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix() / 86400)
+}
+
+// DaysBetween liefert die Anzahl der Kalendertage von t1 bis t2. Beide Daten werden in
+// ihrer jeweiligen Zeitzone betrachtet, ein 23- oder 25-Stunden-Tag zählt als ein Tag.
+func DaysBetween(t1, t2 time.Time) int {
+	return CivilDays(t2) - CivilDays(t1)
+}
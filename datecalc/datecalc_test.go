@@ -0,0 +1,178 @@
+package datecalc
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+// Tage je Monat unabhängig von DaysIn, Index 0 ist Januar
+var monthLengths = [12]int{31, 28, 31, 30, 31, 30, 31, 31, 30, 31, 30, 31}
+
+func monthLength(year int, month time.Month) int {
+	if month == time.February && year%4 == 0 && (year%100 != 0 || year%400 == 0) {
+		return 29
+	}
+	return monthLengths[month-1]
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+// expectedMonthLater liefert den erwarteten Tag einen Monat nach year-month-day
+func expectedMonthLater(year int, month time.Month, day int, policy MonthEndPolicy) time.Time {
+	targetYear, targetMonth := year, month+1
+	if targetMonth > time.December {
+		targetYear, targetMonth = year+1, time.January
+	}
+	lastDay := monthLength(targetYear, targetMonth)
+
+	switch policy {
+	case MonthEndClamp:
+		return date(targetYear, targetMonth, min(day, lastDay))
+	case MonthEndPrevious:
+		if day > lastDay {
+			// Der Tag vor dem ersten fehlenden Tag
+			return date(targetYear, targetMonth, lastDay)
+		}
+		return date(targetYear, targetMonth, day)
+	}
+	if day <= lastDay {
+		return date(targetYear, targetMonth, day)
+	}
+	// Der Überhang zählt in den Folgemonat
+	return date(targetYear, targetMonth+1, day-lastDay)
+}
+
+func TestAddYearsMonthsMonthEnd(t *testing.T) {
+	policies := []MonthEndPolicy{MonthEndNormalize, MonthEndClamp, MonthEndPrevious}
+
+	// Jeder Tag jedes Monats, einmal mit einem gewöhnlichen Jahr und einmal mit einem Schaltjahr
+	// als Zieljahr. Der Dezember führt ins Folgejahr, deshalb beginnt er ein Jahr früher.
+	for _, targetYear := range []int{2023, 2024} {
+		for month := time.January; month <= time.December; month++ {
+			year := targetYear
+			if month == time.December {
+				year--
+			}
+			for day := 1; day <= monthLength(year, month); day++ {
+				for _, policy := range policies {
+					start := date(year, month, day)
+					want := expectedMonthLater(year, month, day, policy)
+					t.Run(fmt.Sprintf("%s+1M/%s", start.Format("2006-01-02"), policy), func(t *testing.T) {
+						got := AddYearsMonths(start, 0, 1, Policy{MonthEnd: policy})
+						if !got.Equal(want) {
+							t.Errorf("AddYearsMonths(%s, 0, 1) = %s, want %s", start.Format("2006-01-02"), got.Format("2006-01-02"), want.Format("2006-01-02"))
+						}
+					})
+				}
+			}
+		}
+	}
+}
+
+func TestAddYearsMonthsYearsEveryDay(t *testing.T) {
+	policies := []MonthEndPolicy{MonthEndNormalize, MonthEndClamp, MonthEndPrevious}
+
+	// Jahrestage jedes Tags aus dem Schaltjahr 2024 in ein gewöhnliches Jahr und ein Schaltjahr.
+	// Nur der 29. Februar fehlt im Zieljahr, alle anderen Tage bleiben unverändert.
+	for _, years := range []int{1, 4} {
+		targetYear := 2024 + years
+		for month := time.January; month <= time.December; month++ {
+			for day := 1; day <= monthLength(2024, month); day++ {
+				for _, policy := range policies {
+					start := date(2024, month, day)
+					want := date(targetYear, month, day)
+					if day > monthLength(targetYear, month) {
+						want = date(targetYear, time.February, 28)
+						if policy == MonthEndNormalize {
+							want = date(targetYear, time.March, 1)
+						}
+					}
+					got := AddYearsMonths(start, years, 0, Policy{MonthEnd: policy})
+					if !got.Equal(want) {
+						t.Errorf("AddYearsMonths(%s, %d, 0, %s) = %s, want %s", start.Format("2006-01-02"), years, policy, got.Format("2006-01-02"), want.Format("2006-01-02"))
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestAddDeadline(t *testing.T) {
+	tests := []struct {
+		start time.Time
+		years int
+		month int
+		want  time.Time
+	}{
+		{date(2025, time.January, 15), 0, 1, date(2025, time.February, 14)},
+		{date(2025, time.March, 1), 0, 1, date(2025, time.March, 31)},
+		{date(2025, time.January, 1), 1, 0, date(2025, time.December, 31)},
+		{date(2025, time.June, 10), 1, 0, date(2026, time.June, 9)},
+		// Fehlt der Vortag im Zielmonat, endet die Frist am letzten Tag des Monats
+		{date(2025, time.January, 31), 0, 1, date(2025, time.February, 28)},
+		{date(2024, time.January, 31), 0, 1, date(2024, time.February, 29)},
+		{date(2024, time.February, 29), 1, 0, date(2025, time.February, 28)},
+		{date(2024, time.February, 29), 4, 0, date(2028, time.February, 28)},
+		{date(2025, time.January, 30), 0, 1, date(2025, time.February, 28)},
+	}
+	for _, tt := range tests {
+		got := AddDeadline(tt.start, tt.years, tt.month)
+		if !got.Equal(tt.want) {
+			t.Errorf("AddDeadline(%s, %d, %d) = %s, want %s", tt.start.Format("2006-01-02"), tt.years, tt.month, got.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestAddYearsMonthsLeapDay(t *testing.T) {
+	birth := date(2024, time.February, 29)
+	tests := []struct {
+		policy Policy
+		years  int
+		months int
+		want   time.Time
+	}{
+		// Ohne Regel für den 29. Februar gilt die Monatsende-Regel
+		{Policy{MonthEnd: MonthEndNormalize}, 1, 0, date(2025, time.March, 1)},
+		{Policy{MonthEnd: MonthEndClamp}, 1, 0, date(2025, time.February, 28)},
+		{Policy{MonthEnd: MonthEndPrevious}, 1, 0, date(2025, time.February, 28)},
+		{Policy{MonthEnd: MonthEndNormalize, LeapDay: LeapDayFeb28}, 1, 0, date(2025, time.February, 28)},
+		{Policy{MonthEnd: MonthEndClamp, LeapDay: LeapDayFeb28}, 1, 0, date(2025, time.February, 28)},
+		{Policy{MonthEnd: MonthEndNormalize, LeapDay: LeapDayMar1}, 1, 0, date(2025, time.March, 1)},
+		{Policy{MonthEnd: MonthEndClamp, LeapDay: LeapDayMar1}, 1, 0, date(2025, time.March, 1)},
+		// Bei only entfällt der Tag beim Aufrufer, gerechnet wird nach der Monatsende-Regel
+		{Policy{MonthEnd: MonthEndNormalize, LeapDay: LeapDayOnly}, 1, 0, date(2025, time.March, 1)},
+		{Policy{MonthEnd: MonthEndClamp, LeapDay: LeapDayOnly}, 1, 0, date(2025, time.February, 28)},
+		{Policy{MonthEnd: MonthEndPrevious, LeapDay: LeapDayMar1}, 1, 0, date(2025, time.March, 1)},
+		// In Schaltjahren gibt es den 29. Februar
+		{Policy{MonthEnd: MonthEndNormalize, LeapDay: LeapDayFeb28}, 4, 0, date(2028, time.February, 29)},
+		{Policy{MonthEnd: MonthEndClamp, LeapDay: LeapDayMar1}, 4, 0, date(2028, time.February, 29)},
+		{Policy{MonthEnd: MonthEndNormalize, LeapDay: LeapDayOnly}, 4, 0, date(2028, time.February, 29)},
+		{Policy{MonthEnd: MonthEndPrevious}, 4, 0, date(2028, time.February, 29)},
+		// Monatstage, die in einen Februar führen
+		{Policy{MonthEnd: MonthEndNormalize, LeapDay: LeapDayFeb28}, 0, 12, date(2025, time.February, 28)},
+		{Policy{MonthEnd: MonthEndNormalize, LeapDay: LeapDayMar1}, 0, 12, date(2025, time.March, 1)},
+		// Andere Monate sind von der Regel nicht betroffen
+		{Policy{MonthEnd: MonthEndNormalize, LeapDay: LeapDayFeb28}, 0, 1, date(2024, time.March, 29)},
+	}
+	for _, tt := range tests {
+		got := AddYearsMonths(birth, tt.years, tt.months, tt.policy)
+		if !got.Equal(tt.want) {
+			t.Errorf("AddYearsMonths(29.02.2024, %d, %d, %+v) = %s, want %s", tt.years, tt.months, tt.policy, got.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestParseMonthEndPolicy(t *testing.T) {
+	for value, want := range map[string]MonthEndPolicy{"": MonthEndNormalize, "normalize": MonthEndNormalize, "clamp": MonthEndClamp, "previous": MonthEndPrevious} {
+		got, err := ParseMonthEndPolicy(value)
+		if err != nil || got != want {
+			t.Errorf("ParseMonthEndPolicy(%q) = %q, %v, want %q", value, got, err, want)
+		}
+	}
+	if _, err := ParseMonthEndPolicy("deadline"); err == nil {
+		t.Error("ParseMonthEndPolicy(\"deadline\") returned no error")
+	}
+}
@@ -0,0 +1,90 @@
+package datecalc
+
+import (
+	"testing"
+	"time"
+	_ "time/tzdata" // Zeitzonen unabhängig von der Installation des Systems
+)
+
+// Sommerzeitwechsel 2024 je Zeitzone: Tag der Umstellung im Frühling und im Herbst
+var dstTransitions = []struct {
+	zone   string
+	spring time.Time
+	autumn time.Time
+}{
+	{"Europe/Berlin", date(2024, time.March, 31), date(2024, time.October, 27)},
+	{"America/New_York", date(2024, time.March, 10), date(2024, time.November, 3)},
+	// Auf der Südhalbkugel beginnt die Sommerzeit im Oktober und endet im April
+	{"Australia/Sydney", date(2024, time.October, 6), date(2024, time.April, 7)},
+}
+
+func inZone(t *testing.T, zone string, d time.Time, hour, minute int) time.Time {
+	t.Helper()
+	loc, err := time.LoadLocation(zone)
+	if err != nil {
+		t.Fatalf("LoadLocation(%q): %v", zone, err)
+	}
+	return time.Date(d.Year(), d.Month(), d.Day(), hour, minute, 0, 0, loc)
+}
+
+func TestDaysBetweenAcrossDST(t *testing.T) {
+	for _, tt := range dstTransitions {
+		for name, transition := range map[string]time.Time{"spring": tt.spring, "autumn": tt.autumn} {
+			t.Run(tt.zone+"/"+name, func(t *testing.T) {
+				before := inZone(t, tt.zone, transition.AddDate(0, 0, -1), 0, 0)
+				after := inZone(t, tt.zone, transition.AddDate(0, 0, 1), 0, 0)
+
+				// Der Tag der Umstellung hat 23 oder 25 Stunden und zählt trotzdem als ein Tag
+				if hours := after.Sub(before).Hours(); hours == 48 {
+					t.Fatalf("no DST transition between %s and %s", before, after)
+				}
+				if got := DaysBetween(before, after); got != 2 {
+					t.Errorf("DaysBetween(%s, %s) = %d, want 2", before, after, got)
+				}
+
+				// Kurz vor Mitternacht und kurz danach liegen einen Kalendertag auseinander
+				late := inZone(t, tt.zone, transition.AddDate(0, 0, -1), 23, 30)
+				early := inZone(t, tt.zone, transition, 0, 30)
+				if got := DaysBetween(late, early); got != 1 {
+					t.Errorf("DaysBetween(%s, %s) = %d, want 1", late, early, got)
+				}
+			})
+		}
+	}
+}
+
+func TestAddPeriodAcrossDST(t *testing.T) {
+	for _, tt := range dstTransitions {
+		for name, transition := range map[string]time.Time{"spring": tt.spring, "autumn": tt.autumn} {
+			t.Run(tt.zone+"/"+name, func(t *testing.T) {
+				// Geburt eine Woche vor der Umstellung um Mitternacht
+				birthDay := transition.AddDate(0, 0, -7)
+				birth := inZone(t, tt.zone, birthDay, 0, 0)
+
+				periods := []struct {
+					years, months, weeks, days int
+					want                       time.Time
+				}{
+					{0, 0, 0, 7, transition},
+					{0, 0, 0, 10, transition.AddDate(0, 0, 3)},
+					{0, 0, 2, 0, transition.AddDate(0, 0, 7)},
+					{0, 0, 0, 100, transition.AddDate(0, 0, 93)},
+					{0, 1, 0, 0, birthDay.AddDate(0, 1, 0)},
+					{1, 0, 0, 0, birthDay.AddDate(1, 0, 0)},
+				}
+				for _, period := range periods {
+					got := AddPeriod(birth, period.years, period.months, period.weeks, period.days, Policy{})
+					wantDays := DaysBetween(birthDay, period.want)
+
+					year, month, day := got.Date()
+					if !date(year, month, day).Equal(period.want) || got.Hour() != 0 || got.Minute() != 0 {
+						t.Errorf("AddPeriod(%s, %d, %d, %d, %d) = %s, want %s 00:00", birth.Format("2006-01-02"), period.years, period.months, period.weeks, period.days, got, period.want.Format("2006-01-02"))
+					}
+					if days := DaysBetween(birth, got); days != wantDays {
+						t.Errorf("DaysBetween(%s, %s) = %d, want %d", birth, got, days, wantDays)
+					}
+				}
+			})
+		}
+	}
+}
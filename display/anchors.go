@@ -0,0 +1,142 @@
+package display
+
+import (
+	"baby-calendar/models"
+	"fmt"
+)
+
+// wording enthält die Texte für eine Art von Ankerereignis. Die Vorlagen der Beschreibung
+// erhalten den Namen (%[1]s), die Anzahl der Tage (%[2]d) und das Wort "Tag" im Nominativ
+// (%[3]s) und im Dativ (%[4]s).
+type wording struct {
+	anchorDate   string // Bezeichnung des Ankerdatums, z.B. "Geburtstag: 21.04.2025"
+	anchorDay    string // Titel für den Tag des Ereignisses selbst
+	periodSuffix string // Wird an Zeitspannen im Titel angehängt, z.B. "100 Tage verheiratet"
+	anniversary  string // Jährlich wiederkehrender Tag, z.B. "Hochzeitstag"
+	eventDay     string // Tag des Ereignisses im Nominativ mit "der", z.B. "Tag der Hochzeit"
+	eventDayGen  string // Tag des Ereignisses im Genitiv mit "des", z.B. "Tags der Hochzeit"
+	eventOn      string // Vor einem Datum, z.B. "geboren am"
+	since        string
+	sinceNoName  string
+	until        string
+	untilNoName  string
+	onDay        string
+	onDayNoName  string
+}
+
+var birthWording = wording{
+	anchorDate:  "Geburtstag",
+	anchorDay:   "Geburtstag",
+	anniversary: "Geburtstag",
+	eventDay:    "Tag der Geburt",
+	eventDayGen: "Geburtstags",
+	eventOn:     "geboren am",
+	since:       "%[1]s ist heute %[2]d %[3]s alt!",
+	sinceNoName: "Das ist heute %[2]d %[3]s her.",
+	until:       "Noch %[2]d %[3]s, bis %[1]s auf die Welt kommt.",
+	untilNoName: "Noch %[2]d %[3]s bis zur Geburt.",
+	onDay:       "%[1]s wird geboren!",
+	onDayNoName: "Geburtstag!",
+}
+
+var wordings = map[models.AnchorType]wording{
+	models.AnchorBirth: birthWording,
+	models.AnchorAdoption: {
+		anchorDate:   "Gotcha Day",
+		anchorDay:    "Gotcha Day",
+		periodSuffix: "bei uns",
+		anniversary:  "Gotcha Day",
+		eventDay:     "Tag der Ankunft",
+		eventDayGen:  "Tags der Ankunft",
+		eventOn:      "angekommen am",
+		since:        "%[1]s ist heute seit %[2]d %[4]s bei uns!",
+		sinceNoName:  "Heute seit %[2]d %[4]s eine Familie.",
+		until:        "Noch %[2]d %[3]s, bis %[1]s nach Hause kommt.",
+		untilNoName:  "Noch %[2]d %[3]s bis zum Gotcha Day.",
+		onDay:        "%[1]s kommt nach Hause!",
+		onDayNoName:  "Gotcha Day!",
+	},
+	models.AnchorWedding: {
+		anchorDate:   "Hochzeitstag",
+		anchorDay:    "Hochzeit",
+		periodSuffix: "verheiratet",
+		anniversary:  "Hochzeitstag",
+		eventDay:     "Tag der Hochzeit",
+		eventDayGen:  "Tags der Hochzeit",
+		eventOn:      "geheiratet am",
+		since:        "%[1]s sind heute %[2]d %[3]s verheiratet!",
+		sinceNoName:  "Heute %[2]d %[3]s verheiratet!",
+		until:        "Noch %[2]d %[3]s bis zur Hochzeit von %[1]s.",
+		untilNoName:  "Noch %[2]d %[3]s bis zur Hochzeit.",
+		onDay:        "%[1]s heiraten!",
+		onDayNoName:  "Hochzeit!",
+	},
+	models.AnchorRelationship: {
+		anchorDate:   "Zusammen seit",
+		anchorDay:    "Der erste gemeinsame Tag",
+		periodSuffix: "zusammen",
+		anniversary:  "Jahrestag",
+		eventDay:     "erste gemeinsame Tag",
+		eventDayGen:  "ersten gemeinsamen Tags",
+		eventOn:      "zusammen seit dem",
+		since:        "%[1]s sind heute %[2]d %[3]s zusammen!",
+		sinceNoName:  "Heute %[2]d %[3]s zusammen!",
+		until:        "Noch %[2]d %[3]s bis zum ersten gemeinsamen Tag von %[1]s.",
+		untilNoName:  "Noch %[2]d %[3]s bis zum ersten gemeinsamen Tag.",
+		onDay:        "%[1]s sind ab heute zusammen!",
+		onDayNoName:  "Der erste gemeinsame Tag!",
+	},
+	models.AnchorPet: birthWording,
+	models.AnchorCustom: {
+		anchorDate:  "Startdatum",
+		anchorDay:   "Starttag",
+		anniversary: "Jahrestag",
+		eventDay:    "Starttag",
+		eventDayGen: "Starttags",
+		eventOn:     "begonnen am",
+		since:       "Seit %[1]s sind heute %[2]d %[3]s vergangen.",
+		sinceNoName: "Das ist heute %[2]d %[3]s her.",
+		until:       "Noch %[2]d %[3]s bis %[1]s.",
+		untilNoName: "Noch %[2]d %[3]s bis zum Starttag.",
+		onDay:       "Heute ist es so weit: %[1]s!",
+		onDayNoName: "Starttag!",
+	},
+}
+
+// getWording liefert die Texte für eine Art von Ankerereignis, unbekannte Arten wie die Geburt
+func getWording(anchor models.AnchorType) wording {
+	if wording, ok := wordings[anchor]; ok {
+		return wording
+	}
+	return birthWording
+}
+
+// GetAnchorPeriod liefert den Titel einer Zeitspanne ab dem Ankerereignis, z.B. "1 Jahr
+// verheiratet". Am Tag des Ereignisses selbst (anchorDay) ist es dessen Bezeichnung.
+func GetAnchorPeriod(anchor models.AnchorType, formattedTimePeriod string, anchorDay bool) string {
+	wording := getWording(anchor)
+	if anchorDay {
+		return wording.anchorDay
+	}
+	if wording.periodSuffix == "" {
+		return formattedTimePeriod
+	}
+	return fmt.Sprintf("%s %s", formattedTimePeriod, wording.periodSuffix)
+}
+
+// GetAnniversaryWeekday beschreibt einen Jahrestag am selben Wochentag wie das Ereignis, z.B.
+// "6. Geburtstag an einem Sonntag"
+func GetAnniversaryWeekday(anchor models.AnchorType, years int, weekday string) string {
+	return fmt.Sprintf("%d. %s an einem %s", years, getWording(anchor).anniversary, weekday)
+}
+
+// GetAnniversaryWeekdayDescription erklärt einen Jahrestag am selben Wochentag wie das Ereignis
+func GetAnniversaryWeekdayDescription(anchor models.AnchorType, weekday string) string {
+	wording := getWording(anchor)
+	return fmt.Sprintf("Der %s fällt wie der %s auf einen %s.", wording.anniversary, wording.eventDay, weekday)
+}
+
+// GetCountedFromMidnight beschreibt eine Uhrzeit, gezählt ab Mitternacht am Tag des Ereignisses
+func GetCountedFromMidnight(anchor models.AnchorType, hour, minute, second int64) string {
+	return fmt.Sprintf("Erreicht um %02d:%02d:%02d Uhr, gezählt ab Mitternacht des %s.", hour, minute, second, getWording(anchor).eventDayGen)
+}
@@ -1,6 +1,7 @@
 package display
 
 import (
+	"baby-calendar/models"
 	"fmt"
 	"strings"
 	"time"
@@ -19,32 +20,240 @@ func GetSummary(name, formattedTimePeriod string, includeEmoji bool, emoji strin
 	return summary
 }
 
-func GetDescription(name string, DaysBetween int, birthDate time.Time) string {
+// GetDescription beschreibt einen Eintrag mit der Wortwahl für die Art des Ankerereignisses,
+// notes werden als eigene Zeilen angehängt
+func GetDescription(anchor models.AnchorType, name string, DaysBetween int, birthDate time.Time, notes []string) string {
+	wording := getWording(anchor)
+
+	days := DaysBetween
+	if days < 0 {
+		days = -days
+	}
+	dayText, dayTextDative := "Tage", "Tagen"
+	if days == 1 {
+		dayText, dayTextDative = "Tag", "Tag"
+	}
+
+	var descriptions []string
+
+	if DaysBetween != 0 {
+		descriptions = append(descriptions, fmt.Sprintf("%s: %s", wording.anchorDate, birthDate.Format("02.01.2006")))
+	}
+
+	var template string
+	switch {
+	case name != "" && DaysBetween > 0:
+		template = wording.since
+	case name != "" && DaysBetween < 0:
+		template = wording.until
+	case name != "":
+		template = wording.onDay
+	case DaysBetween > 0:
+		template = wording.sinceNoName
+	case DaysBetween < 0:
+		template = wording.untilNoName
+	default:
+		template = wording.onDayNoName
+	}
+	descriptions = append(descriptions, fmt.Sprintf(template, name, days, dayText, dayTextDative))
+	descriptions = append(descriptions, notes...)
+	return strings.Join(descriptions, "\n")
+}
+
+// GetDaysRemaining beschreibt den Abstand bis zu einem Meilenstein
+func GetDaysRemaining(days int) string {
+	switch days {
+	case 0:
+		return "heute"
+	case 1:
+		return "morgen"
+	default:
+		return fmt.Sprintf("in %d Tagen", days)
+	}
+}
+
+// GetPregnancyDescription beschreibt einen Eintrag der Schwangerschaft relativ zum errechneten Termin
+func GetPregnancyDescription(daysToDue int, dueDate time.Time) string {
 	var dayText string
-	if DaysBetween == 1 {
+	if daysToDue == 1 || daysToDue == -1 {
 		dayText = "Tag"
 	} else {
 		dayText = "Tage"
 	}
 
-	var descriptions []string
+	switch {
+	case daysToDue > 0:
+		return fmt.Sprintf("Noch %d %s bis zum errechneten Termin am %s.", daysToDue, dayText, dueDate.Format("02.01.2006"))
+	case daysToDue < 0:
+		return fmt.Sprintf("%d %s nach dem errechneten Termin am %s.", -daysToDue, dayText, dueDate.Format("02.01.2006"))
+	}
+	return fmt.Sprintf("Heute ist der errechnete Termin (%s).", dueDate.Format("02.01.2006"))
+}
 
-	if DaysBetween > 0 {
-		descriptions = append(descriptions, fmt.Sprintf("Geburtstag: %s", birthDate.Format("02.01.2006")))
+// GetCheckupDescription beschreibt den Zeitraum einer Vorsorgeuntersuchung mit ihren Toleranzgrenzen
+func GetCheckupDescription(name, title, window string, start, end, toleranceStart, toleranceEnd time.Time) string {
+	descriptions := []string{fmt.Sprintf("%s (%s) %s.", name, title, window)}
+	if start.Equal(end) {
+		descriptions = append(descriptions, fmt.Sprintf("Empfohlener Tag: %s.", start.Format("02.01.2006")))
+	} else {
+		descriptions = append(descriptions, fmt.Sprintf("Empfohlener Zeitraum: %s bis %s.", start.Format("02.01.2006"), end.Format("02.01.2006")))
+	}
+	if !toleranceStart.Equal(start) || !toleranceEnd.Equal(end) {
+		descriptions = append(descriptions, fmt.Sprintf("Toleranzgrenzen: %s bis %s.", toleranceStart.Format("02.01.2006"), toleranceEnd.Format("02.01.2006")))
 	}
+	return strings.Join(descriptions, "\n")
+}
 
-	if name != "" {
-		if DaysBetween > 0 {
-			descriptions = append(descriptions, fmt.Sprintf("%s ist heute %d %s alt!", name, DaysBetween, dayText))
-		} else {
-			descriptions = append(descriptions, fmt.Sprintf("%s wird geboren!", name))
+// GetCheckupReminderDescription erinnert daran, einen Termin für eine Vorsorgeuntersuchung zu vereinbaren
+func GetCheckupReminderDescription(name string, start, end time.Time) string {
+	return fmt.Sprintf("Der Zeitraum für die %s beginnt am %s und endet am %s. Jetzt einen Termin in der Kinderarztpraxis vereinbaren.", name, start.Format("02.01.2006"), end.Format("02.01.2006"))
+}
+
+// GetVaccinationDescription beschreibt eine empfohlene Impfdosis. Wurde sie wegen des
+// Mindestabstands zur vorherigen Dosis verschoben, nennt sie auch das ursprüngliche Datum.
+func GetVaccinationDescription(diseases, recommendedAge string, recommended time.Time, shifted bool, minInterval string, previousDose int) string {
+	descriptions := []string{fmt.Sprintf("Impfung gegen %s. Von der STIKO empfohlenes Alter: %s.", diseases, recommendedAge)}
+	if shifted {
+		descriptions = append(descriptions, fmt.Sprintf("Verschoben wegen des Mindestabstands zur %d. Dosis (%s), empfohlen war der %s.", previousDose, minInterval, recommended.Format("02.01.2006")))
+	}
+	return strings.Join(descriptions, "\n")
+}
+
+// GetGivenVaccinationDescription beschreibt eine bereits gegebene Impfdosis
+func GetGivenVaccinationDescription(diseases string, given time.Time) string {
+	return fmt.Sprintf("Impfung gegen %s, gegeben am %s.", diseases, given.Format("02.01.2006"))
+}
+
+// GetSourcedDescription ergänzt eine Beschreibung um ihre Rechtsgrundlage
+func GetSourcedDescription(description, source string) string {
+	return fmt.Sprintf("%s\nQuelle: %s", description, source)
+}
+
+// GetProtectionDescription beschreibt die Schutzfrist des Mutterschutzes mit der Anzahl der
+// Wochen nach der Geburt (8, nach einer Frühgeburt 12)
+func GetProtectionDescription(start, end time.Time, weeksAfterBirth int, reasons []string) string {
+	descriptions := []string{fmt.Sprintf("Schutzfrist vom %s bis %s: sechs Wochen vor dem errechneten Termin bis %d Wochen nach der Geburt.", start.Format("02.01.2006"), end.Format("02.01.2006"), weeksAfterBirth)}
+	descriptions = append(descriptions, reasons...)
+	return strings.Join(descriptions, " ")
+}
+
+// GetSchoolDescription beschreibt die Einschulung oder den Beginn eines weiteren Schuljahrs
+func GetSchoolDescription(stateName string, grade, year, cutoffDay int, cutoffMonth time.Month, enrollmentYear int, note, version string) string {
+	schoolYear := fmt.Sprintf("%d/%02d", year, (year+1)%100)
+	var descriptions []string
+	if grade == 1 {
+		descriptions = append(descriptions, fmt.Sprintf("Voraussichtliche Einschulung in %s zum Schuljahr %s. Schulpflichtig sind Kinder, die bis zum %02d.%02d. sechs Jahre alt werden.", stateName, schoolYear, cutoffDay, cutoffMonth))
+		if note != "" {
+			descriptions = append(descriptions, note)
 		}
+		descriptions = append(descriptions, "Der erste Schultag liegt nach den Sommerferien.")
 	} else {
-		if DaysBetween > 0 {
-			descriptions = append(descriptions, fmt.Sprintf("Das ist heute %d %s her.", DaysBetween, dayText))
-		} else {
-			descriptions = append(descriptions, fmt.Sprintf("Geburtstag!"))
-		}
+		descriptions = append(descriptions, fmt.Sprintf("Schuljahr %s in %s, gerechnet ab der Einschulung %d ohne Wiederholen oder Überspringen einer Klasse.", schoolYear, stateName, enrollmentYear))
+	}
+	descriptions = append(descriptions, fmt.Sprintf("Regeln mit Stand %s.", version))
+	return strings.Join(descriptions, "\n")
+}
+
+// GetPlanetYears beschreibt eine Anzahl von Jahren auf einem Planeten, z.B. "1 Mars-Jahr"
+func GetPlanetYears(years int, planetName string) string {
+	if years == 1 {
+		return fmt.Sprintf("1 %s-Jahr", planetName)
+	}
+	return fmt.Sprintf("%d %s-Jahre", years, planetName)
+}
+
+// GetPlanetAge beschreibt ein Alter in Jahren eines Planeten mit zwei Nachkommastellen, z.B.
+// "1,53 Mars-Jahre"
+func GetPlanetAge(years float64, planetName string) string {
+	return strings.Replace(fmt.Sprintf("%.2f %s-Jahre", years, planetName), ".", ",", 1)
+}
+
+// GetPlanetDescription erklärt einen Jahrestag auf einem anderen Planeten
+func GetPlanetDescription(anchor models.AnchorType, planetName string, orbitalPeriod float64) string {
+	period := strings.Replace(fmt.Sprintf("%.2f", orbitalPeriod), ".", ",", 1)
+	return fmt.Sprintf("%s auf dem Planeten %s: Ein %s-Jahr dauert %s Erdtage, so lange braucht der Planet für eine Runde um die Sonne.", getWording(anchor).anniversary, planetName, planetName, period)
+}
+
+// GetCalendarDescription beschreibt einen Jahrestag in einem anderen Kalendersystem.
+// calendarName ist das Adjektiv zum Kalender, z.B. "hebräisch".
+func GetCalendarDescription(anchor models.AnchorType, calendarName, anchorDate, date, missingDate string) string {
+	wording := getWording(anchor)
+	descriptions := []string{fmt.Sprintf("%s nach dem %sen Kalender: %s (%s %s)", wording.anniversary, calendarName, date, wording.eventOn, anchorDate)}
+	if missingDate != "" {
+		descriptions = append(descriptions, fmt.Sprintf("Den %s gibt es nicht, deshalb zählt der letzte Tag des Monats.", missingDate))
 	}
 	return strings.Join(descriptions, "\n")
 }
+
+// Vielfache für das Verhältnis zweier Alter
+var ratioWords = map[int]string{2: "doppelt", 3: "dreimal", 4: "viermal", 5: "fünfmal"}
+
+// GetRatioLabel beschreibt, wie viel älter eine Person als eine andere ist, z.B. "jetzt doppelt
+// so alt wie Emil"
+func GetRatioLabel(ratio int, youngerName string) string {
+	word, ok := ratioWords[ratio]
+	if !ok {
+		word = fmt.Sprintf("%d-mal", ratio)
+	}
+	return fmt.Sprintf("jetzt %s so alt wie %s", word, youngerName)
+}
+
+// GetRatioDescription erklärt das Verhältnis zweier Alter in Tagen
+func GetRatioDescription(olderName string, olderDays int, youngerName string, youngerDays int) string {
+	return fmt.Sprintf("%s ist heute %d Tage alt, %s %d Tage.", olderName, olderDays, youngerName, youngerDays)
+}
+
+// GetSince beschreibt eine Dauer seit einem Ereignis, z.B. "seit einem Jahr" oder "seit 1000 Tagen"
+func GetSince(years, days int) string {
+	switch {
+	case years == 1:
+		return "seit einem Jahr"
+	case years > 1:
+		return fmt.Sprintf("seit %d Jahren", years)
+	case days == 1:
+		return "seit einem Tag"
+	}
+	return fmt.Sprintf("seit %d Tagen", days)
+}
+
+// JoinNames verbindet Namen mit Kommas und "und", z.B. "Ida, Emil und Max"
+func JoinNames(names []string) string {
+	if len(names) < 2 {
+		return strings.Join(names, "")
+	}
+	last := len(names) - 1
+	return strings.Join(names[:last], ", ") + " und " + names[last]
+}
+
+// GetCombinedAgeDescription beschreibt das gemeinsame Alter mehrerer Personen
+func GetCombinedAgeDescription(names []string, years, totalDays int) string {
+	return fmt.Sprintf("%s sind heute zusammen %d Jahre alt (%d Tage).", JoinNames(names), years, totalDays)
+}
+
+// GetPetYears beschreibt das Alter eines Haustiers in Jahren seiner Art, z.B. "1 Hundejahr"
+func GetPetYears(years int, yearWord string) string {
+	if years == 1 {
+		return fmt.Sprintf("1 %sjahr", yearWord)
+	}
+	return fmt.Sprintf("%d %sjahre", years, yearWord)
+}
+
+// GetHumanYears beschreibt ein umgerechnetes Alter in Menschenjahren
+func GetHumanYears(humanYears int) string {
+	return fmt.Sprintf("%d Menschenjahre", humanYears)
+}
+
+// GetPetYearsDescription rechnet die Jahre eines Haustiers in Menschenjahre um.
+// speciesDescription nennt die Tierart, z.B. "Katze".
+func GetPetYearsDescription(years int, yearWord string, humanYears int, speciesDescription string) string {
+	verb := "entsprechen"
+	if years == 1 {
+		verb = "entspricht"
+	}
+	return fmt.Sprintf("%s %s etwa %d Menschenjahren (%s).", GetPetYears(years, yearWord), verb, humanYears, speciesDescription)
+}
+
+// GetPetHumanAgeDescription erklärt ein rundes Alter in Menschenjahren
+func GetPetHumanAgeDescription(humanYears int, speciesDescription string) string {
+	return fmt.Sprintf("Umgerechnet heute so alt wie ein Mensch mit %d Jahren (%s).", humanYears, speciesDescription)
+}
@@ -3,6 +3,6 @@ module baby-calendar
 go 1.21
 
 require (
-	github.com/arran4/golang-ical v0.3.2 // indirect
-	github.com/rs/cors v1.11.1 // indirect
+	github.com/arran4/golang-ical v0.3.2
+	github.com/rs/cors v1.11.1
 )
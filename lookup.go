@@ -0,0 +1,208 @@
+package main
+
+import (
+	"baby-calendar/cache"
+	"baby-calendar/models"
+	"baby-calendar/output"
+	"baby-calendar/processor"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// Maximale Anzahl an Personen in einer Abfrage
+const maxLookupPersons = 10
+
+// parsePerson liest eine Person im Format "[Name[:Rolle]=]YYYY-MM-DD" in der Zeitzone loc,
+// z.B. "Mama:mother=1990-05-01". Ohne Namen wird fallbackName verwendet.
+func parsePerson(value, fallbackName string, loc *time.Location) (models.Person, error) {
+	name := fallbackName
+	role := ""
+	dateValue := value
+	if before, after, found := strings.Cut(value, "="); found {
+		before, role, _ = strings.Cut(before, ":")
+		if cleanName := cache.SanitizeName(before); cleanName != "" {
+			name = cleanName
+		}
+		dateValue = after
+	}
+	role = strings.ToLower(role)
+	if err := processor.ValidateRole(role); err != nil {
+		return models.Person{}, err
+	}
+
+	birth, err := time.ParseInLocation("2006-01-02", dateValue, loc)
+	if err != nil {
+		return models.Person{}, fmt.Errorf("Invalid birth date format %q. Use YYYY-MM-DD.", dateValue)
+	}
+	return models.Person{Name: name, Birth: birth, Role: role}, nil
+}
+
+// parseLookupPersons liest die Personen aus den wiederholten Parametern birth und name.
+// Der n-te Name gehört zum n-ten Geburtsdatum.
+func parseLookupPersons(query url.Values) ([]models.Person, error) {
+	births := query["birth"]
+	names := query["name"]
+	if len(births) == 0 {
+		return nil, fmt.Errorf("Missing birth parameter.")
+	}
+	if len(births) > maxLookupPersons {
+		return nil, fmt.Errorf("Too many birth parameters. Use at most %d.", maxLookupPersons)
+	}
+	loc, err := getLocation(query)
+	if err != nil {
+		return nil, err
+	}
+
+	var persons []models.Person
+	for i, birth := range births {
+		fallbackName := fmt.Sprintf("Kind %d", i+1)
+		if i < len(names) && cache.SanitizeName(names[i]) != "" {
+			fallbackName = cache.SanitizeName(names[i])
+		}
+		person, err := parsePerson(birth, fallbackName, loc)
+		if err != nil {
+			return nil, err
+		}
+		persons = append(persons, person)
+	}
+	return persons, nil
+}
+
+// parseLookupRange liest den Zeitraum aus from und to. Ohne to wird nur der Tag from abgefragt.
+func parseLookupRange(query url.Values) (time.Time, time.Time, error) {
+	loc, err := getLocation(query)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	from, err := parseDateParam(query, "from", loc, today(loc))
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	to, err := parseDateParam(query, "to", loc, from)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if to.Before(from) {
+		return time.Time{}, time.Time{}, fmt.Errorf("The to date must not be before the from date.")
+	}
+	return from, to, nil
+}
+
+// handleLookupRequest liefert die Meilensteine mehrerer Kinder in einem Zeitraum
+func handleLookupRequest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+	persons, err := parseLookupPersons(query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	from, to, err := parseLookupRange(query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	opts, err := getOptions(query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	includeEmoji := query.Has("emoji")
+
+	days := processor.LookupResults(dataSets, persons, from, to, getExcludedCategories(query), opts)
+
+	if query.Get("format") == "text" {
+		writeText(w, output.GenerateLookupText(from, to, days, includeEmoji))
+		return
+	}
+	writeJSON(w, output.GenerateLookupJSON(from, to, days, includeEmoji))
+}
+
+// runLookupCommand führt die Suche auf der Kommandozeile aus, z.B.
+// baby-calendar lookup -from 2026-12-01 -to 2026-12-31 Ida=2022-03-04 Emil=2025-04-21
+func runLookupCommand(args []string) error {
+	flags := flag.NewFlagSet("lookup", flag.ContinueOnError)
+	fromValue := flags.String("from", "", "Beginn des Zeitraums im Format YYYY-MM-DD (Standard: heute)")
+	toValue := flags.String("to", "", "Ende des Zeitraums im Format YYYY-MM-DD (Standard: from)")
+	format := flags.String("format", "text", "Ausgabeformat (text oder json)")
+	options := flags.String("options", "", "Weitere Parameter wie bei /subscribe, z.B. \"include-birthdays&emoji\"")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Nutzung: baby-calendar lookup [Optionen] [Name=]YYYY-MM-DD ...")
+		flags.PrintDefaults()
+	}
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+
+	query, err := url.ParseQuery(*options)
+	if err != nil {
+		return fmt.Errorf("Ungültige Optionen: %w", err)
+	}
+	query.Set("from", *fromValue)
+	query.Set("to", *toValue)
+	for _, arg := range flags.Args() {
+		query.Add("birth", arg)
+	}
+
+	persons, err := parseLookupPersons(query)
+	if err != nil {
+		return err
+	}
+	from, to, err := parseLookupRange(query)
+	if err != nil {
+		return err
+	}
+	opts, err := getOptions(query)
+	if err != nil {
+		return err
+	}
+	includeEmoji := query.Has("emoji")
+
+	days := processor.LookupResults(dataSets, persons, from, to, getExcludedCategories(query), opts)
+
+	if *format == "json" {
+		data, err := json.MarshalIndent(output.GenerateLookupJSON(from, to, days, includeEmoji), "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(data))
+		return nil
+	}
+	fmt.Print(output.GenerateLookupText(from, to, days, includeEmoji))
+	return nil
+}
+
+// runCommand führt einen Befehl auf der Kommandozeile aus und liefert den Exit-Code
+func runCommand(args []string) int {
+	var err error
+	dataSets, err = loadDataSets()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+
+	switch args[0] {
+	case "lookup":
+		err = runLookupCommand(args[1:])
+	default:
+		err = fmt.Errorf("Unbekannter Befehl: %s", args[0])
+	}
+	if err == flag.ErrHelp {
+		return 0
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,63 @@
+package main
+
+import (
+	"baby-calendar/models"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestHandleLookupRequest(t *testing.T) {
+	var lookup models.LookupJSON
+	decodeJSON(t, request(handleLookupRequest, "/lookup?birth=Ida=2022-03-04&birth=2025-04-21&name=&name=Emil&from=2025-07-30&to=2025-07-30"), &lookup)
+	if lookup.From != "2025-07-30" || lookup.To != "2025-07-30" || len(lookup.Days) != 1 {
+		t.Fatalf("got %+v", lookup)
+	}
+	day := lookup.Days[0]
+	if day.Shared || len(day.Results) != 1 || day.Results[0].Name != "Emil" || day.Results[0].FormattedTimePeriod != "100 Tage" {
+		t.Errorf("day = %+v", day)
+	}
+
+	// Zwillinge mit demselben Namen teilen jeden Tag
+	lookup = models.LookupJSON{}
+	decodeJSON(t, request(handleLookupRequest, "/lookup?birth=Kind=2025-04-21&birth=Kind=2025-04-21&from=2025-07-30"), &lookup)
+	if len(lookup.Days) != 1 || !lookup.Days[0].Shared || len(lookup.SharedDates) != 1 || lookup.SharedDates[0] != "2025-07-30" {
+		t.Errorf("twins = %+v", lookup)
+	}
+
+	// Das gemeinsame Alter macht den Tag nicht zu einem gemeinsamen Tag
+	lookup = models.LookupJSON{}
+	decodeJSON(t, request(handleLookupRequest, "/lookup?birth=Ida:girl=2022-03-04&birth=Emil:boy=2025-04-21&from=2026-03-28&include-relations"), &lookup)
+	if len(lookup.Days) != 1 || lookup.Days[0].Shared || lookup.Days[0].Results[0].FormattedTimePeriod != "zusammen 5 Jahre alt" {
+		t.Errorf("combined age day = %+v", lookup)
+	}
+
+	text := request(handleLookupRequest, "/lookup?birth=Emil=2025-04-21&from=2025-07-30&format=text")
+	if !strings.Contains(text.Body.String(), "Emil 100 Tage") {
+		t.Errorf("text response = %q", text.Body.String())
+	}
+}
+
+func TestHandleLookupRequestErrors(t *testing.T) {
+	tooMany := "/lookup?from=2025-07-30"
+	for i := 0; i <= maxLookupPersons; i++ {
+		tooMany += "&birth=2025-04-21"
+	}
+	tests := []struct {
+		target string
+		want   string
+	}{
+		{"/lookup?from=2025-07-30", "Missing birth parameter."},
+		{tooMany, fmt.Sprintf("Too many birth parameters. Use at most %d.", maxLookupPersons)},
+		{"/lookup?birth=Ida:sister=2022-03-04", "Invalid role \"sister\""},
+		{"/lookup?birth=Ida=04.03.2022", "Invalid birth date format \"04.03.2022\""},
+		{"/lookup?birth=2025-04-21&from=2025-07-30&to=2025-07-01", "The to date must not be before the from date."},
+	}
+	for _, tt := range tests {
+		recorder := request(handleLookupRequest, tt.target)
+		if recorder.Code != http.StatusBadRequest || !strings.Contains(recorder.Body.String(), tt.want) {
+			t.Errorf("%s = %d %q, want 400 %q", tt.target, recorder.Code, recorder.Body.String(), tt.want)
+		}
+	}
+}
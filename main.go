@@ -2,6 +2,7 @@ package main
 
 import (
 	"baby-calendar/cache"
+	"baby-calendar/datecalc"
 	"baby-calendar/models"
 	"baby-calendar/output"
 	"baby-calendar/processor"
@@ -10,7 +11,11 @@ import (
 	"log"
 	"net/http"
 	"net/url"
+	"os"
+	"slices"
+	"strings"
 	"time"
+	_ "time/tzdata" // Zeitzonen auch im Alpine-Image ohne tzdata verfügbar machen
 
 	"github.com/rs/cors"
 )
@@ -18,26 +23,66 @@ import (
 const version = "0.2.10"
 const port = 8080
 
-// Global verfügbare timePeriods - werden nur einmal beim Serverstart geladen
-var timePeriods []models.TimePeriod
+// Anzahl der vergangenen und kommenden Meilensteine in den Feeds
+const feedRecentItems = 10
+const feedUpcomingItems = 3
+
+// Global verfügbare Datensätze - werden nur einmal beim Serverstart geladen
+var dataSets processor.DataSets
+
+// loadDataSets lädt alle Datensätze aus dem data-Verzeichnis
+func loadDataSets() (processor.DataSets, error) {
+	timePeriods, err := processor.LoadTimePeriods("data/periods.json")
+	if err != nil {
+		return processor.DataSets{}, fmt.Errorf("Fehler beim Laden der Zeitperioden: %w", err)
+	}
+	checkups, err := processor.LoadCheckups("data/checkups.json")
+	if err != nil {
+		return processor.DataSets{}, fmt.Errorf("Fehler beim Laden der Vorsorgeuntersuchungen: %w", err)
+	}
+	vaccinations, err := processor.LoadVaccinations("data/vaccinations.json")
+	if err != nil {
+		return processor.DataSets{}, fmt.Errorf("Fehler beim Laden des Impfkalenders: %w", err)
+	}
+	school, err := processor.LoadSchoolRules("data/school.json")
+	if err != nil {
+		return processor.DataSets{}, fmt.Errorf("Fehler beim Laden der Regeln für Kita und Schule: %w", err)
+	}
+	legal, err := processor.LoadLegalRules("data/legal.json")
+	if err != nil {
+		return processor.DataSets{}, fmt.Errorf("Fehler beim Laden der rechtlichen Altersgrenzen: %w", err)
+	}
+	return processor.DataSets{TimePeriods: timePeriods, Checkups: checkups, Vaccinations: vaccinations, School: school, Legal: legal}, nil
+}
 
 func main() {
+	// Befehle auf der Kommandozeile statt des Servers ausführen
+	if len(os.Args) > 1 {
+		os.Exit(runCommand(os.Args[1:]))
+	}
+
 	// Sicherstellen, dass das Cache-Verzeichnis existiert
 	if err := cache.CreateCacheDir(); err != nil {
 		log.Fatal(err)
 		return
 	}
 
-	// Lade timePeriods einmalig beim Serverstart
+	// Lade die Datensätze einmalig beim Serverstart
 	var err error
-	timePeriods, err = processor.LoadTimePeriods("data/periods.json")
+	dataSets, err = loadDataSets()
 	if err != nil {
-		fmt.Printf("Fehler beim Laden der Zeitperioden: %v\n", err)
+		fmt.Println(err)
 		return
 	}
-	fmt.Printf("%d Zeitperioden erfolgreich geladen\n", len(timePeriods))
+	fmt.Printf("%d Zeitperioden, %d Vorsorgeuntersuchungen und %d Impfdosen erfolgreich geladen\n", len(dataSets.TimePeriods), len(dataSets.Checkups), len(dataSets.Vaccinations))
+	fmt.Printf("Regeln für Kita und Schule mit Stand %s für %d Bundesländer geladen\n", dataSets.School.Version, len(dataSets.School.States))
+	fmt.Printf("Rechtliche Altersgrenzen mit Stand %s für %d Länder geladen\n", dataSets.Legal.Version, len(dataSets.Legal.Countries))
 
 	http.HandleFunc("/subscribe", handleCalendarRequest)
+	http.HandleFunc("/upcoming", handleUpcomingRequest)
+	http.HandleFunc("/today", handleTodayRequest)
+	http.HandleFunc("/age", handleAgeRequest)
+	http.HandleFunc("/lookup", handleLookupRequest)
 	c := cors.New(cors.Options{
 		AllowedOrigins: []string{
 			"http://localhost:5173", // SvelteKit dev Server
@@ -64,7 +109,12 @@ func getExcludedCategories(query url.Values) []string {
 	if !query.Has("include-birth") {
 		excludedCategories = append(excludedCategories, "birth")
 	}
-	if !query.Has("include-birthdays") {
+	// Ausdrücklich ausgeblendete Kategorien bleiben es auch, wenn das Ankerereignis sie standardmäßig anzeigt
+	var explicitlyExcluded []string
+	if query.Has("exclude-birthdays") {
+		explicitlyExcluded = append(explicitlyExcluded, "birthday")
+	}
+	if !query.Has("include-birthdays") || query.Has("exclude-birthdays") {
 		excludedCategories = append(excludedCategories, "birthday")
 	}
 	if query.Has("exclude-first-year-weeks") {
@@ -79,17 +129,59 @@ func getExcludedCategories(query url.Values) []string {
 	if !query.Has("include-second-year-months") {
 		excludedCategories = append(excludedCategories, "second-year-months")
 	}
-	return excludedCategories
-}
-
-func handleCalendarRequest(w http.ResponseWriter, r *http.Request) {
-	if r.Method != http.MethodGet {
-		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
-		return
+	if query.Has("exclude-pregnancy") {
+		excludedCategories = append(excludedCategories, "pregnancy")
+	}
+	if !query.Has("include-fibonacci-days") {
+		excludedCategories = append(excludedCategories, "fibonacci-days")
+	}
+	if !query.Has("include-phases") {
+		excludedCategories = append(excludedCategories, "phases")
+	}
+	if !query.Has("include-parental") {
+		excludedCategories = append(excludedCategories, "parental")
+	}
+	if !query.Has("include-checkups") {
+		excludedCategories = append(excludedCategories, "medical-checkups")
+	}
+	if !query.Has("include-vaccinations") {
+		excludedCategories = append(excludedCategories, "vaccinations")
+	}
+	if !query.Has("include-firsts") {
+		excludedCategories = append(excludedCategories, "firsts")
+	}
+	if !query.Has("include-date-patterns") {
+		excludedCategories = append(excludedCategories, "date-patterns")
+	}
+	if !query.Has("include-relations") {
+		excludedCategories = append(excludedCategories, "relations")
+	}
+	if !query.Has("include-nerd") {
+		excludedCategories = append(excludedCategories, "nerd")
+	}
+	if query.Has("exclude-pet-years") {
+		excludedCategories = append(excludedCategories, "pet-years")
+	}
+	if query.Has("exclude-pet-human-age") {
+		excludedCategories = append(excludedCategories, "pet-human-age")
 	}
 
-	query := r.URL.Query()
+	// Je nach Ankerereignis sind Jahrestage standardmäßig sichtbar und die Kategorien rund um
+	// die Geburt eines Kindes ausgeblendet. Ein ungültiger Wert wird in getOptions gemeldet.
+	anchor, _ := processor.ParseAnchorType(strings.ToLower(query.Get("anchor")))
+	included, excluded := processor.AnchorCategories(anchor)
+	excludedCategories = slices.DeleteFunc(excludedCategories, func(category string) bool {
+		return slices.Contains(included, category) && !slices.Contains(explicitlyExcluded, category)
+	})
+	for _, category := range excluded {
+		if !slices.Contains(excludedCategories, category) {
+			excludedCategories = append(excludedCategories, category)
+		}
+	}
+	return excludedCategories
+}
 
+func getCleanName(query url.Values) string {
 	var name string
 	if query.Has("name") {
 		// Parameter existiert (mit oder ohne Wert)
@@ -99,11 +191,149 @@ func handleCalendarRequest(w http.ResponseWriter, r *http.Request) {
 	if name != "" {
 		cleanName = cache.SanitizeName(name)
 	}
+	return cleanName
+}
+
+func isFeedFormat(format string) bool {
+	return format == "atom" || format == "rss" || format == "jsonfeed"
+}
+
+// getOptions liest die Einstellungen für die Berechnung aus der Query
+func getOptions(query url.Values) (processor.Options, error) {
+	monthEnd, err := datecalc.ParseMonthEndPolicy(query.Get("month-end"))
+	if err != nil {
+		return processor.Options{}, err
+	}
+	anchor, err := processor.ParseAnchorType(strings.ToLower(query.Get("anchor")))
+	if err != nil {
+		return processor.Options{}, err
+	}
+	var species string
+	if query.Has("species") {
+		if anchor != models.AnchorPet {
+			return processor.Options{}, fmt.Errorf("The species parameter requires anchor=pet.")
+		}
+		species, err = processor.ParseSpecies(strings.ToLower(query.Get("species")))
+		if err != nil {
+			return processor.Options{}, err
+		}
+	}
+	leapDay, err := datecalc.ParseLeapDayPolicy(query.Get("leap-day"))
+	if err != nil {
+		return processor.Options{}, err
+	}
+	ageMode, err := processor.ParseAgeMode(query.Get("age"))
+	if err != nil {
+		return processor.Options{}, err
+	}
+	loc, err := getLocation(query)
+	if err != nil {
+		return processor.Options{}, err
+	}
+	due, err := parseDateParam(query, "due", loc, time.Time{})
+	if err != nil {
+		return processor.Options{}, err
+	}
+	if due.IsZero() {
+		// Ohne Termin kann er aus dem ersten Tag der letzten Periode berechnet werden
+		lmp, err := parseDateParam(query, "lmp", loc, time.Time{})
+		if err != nil {
+			return processor.Options{}, err
+		}
+		if !lmp.IsZero() {
+			due = processor.DueFromLastPeriod(lmp)
+		}
+	}
+	var checkupReminderDays int
+	if query.Has("checkup-reminder") {
+		checkupReminderDays, err = processor.ParseCheckupReminderDays(query.Get("checkup-reminder"))
+		if err != nil {
+			return processor.Options{}, err
+		}
+	}
+	// Gegebene Impfdosen können wiederholt oder durch Kommas getrennt angegeben werden
+	givenDoses := map[string]time.Time{}
+	for _, values := range query["vaccinated"] {
+		for _, value := range strings.Split(values, ",") {
+			if value == "" {
+				continue
+			}
+			key, date, err := processor.ParseGivenDose(dataSets.Vaccinations, value, loc)
+			if err != nil {
+				return processor.Options{}, err
+			}
+			givenDoses[key] = date
+		}
+	}
+	state := strings.ToUpper(query.Get("state"))
+	if state != "" {
+		if err := processor.ValidateState(dataSets.School, state); err != nil {
+			return processor.Options{}, err
+		}
+	}
+	country := strings.ToUpper(query.Get("country"))
+	if country != "" {
+		if err := processor.ValidateCountry(dataSets.Legal, country); err != nil {
+			return processor.Options{}, err
+		}
+	}
+	var planets []string
+	if query.Has("planets") {
+		planets, err = processor.ParsePlanets(query.Get("planets"))
+		if err != nil {
+			return processor.Options{}, err
+		}
+	}
+	var calendarSystems []string
+	if query.Has("calendars") {
+		calendarSystems, err = processor.ParseCalendars(query.Get("calendars"))
+		if err != nil {
+			return processor.Options{}, err
+		}
+	}
+	// Weitere Personen der Familie für die Meilensteine zwischen Personen, Format wie bei /lookup
+	var relatives []models.Person
+	if len(query["relative"]) > maxLookupPersons-1 {
+		return processor.Options{}, fmt.Errorf("Too many relative parameters. Use at most %d.", maxLookupPersons-1)
+	}
+	for _, value := range query["relative"] {
+		relative, err := parsePerson(value, "", loc)
+		if err != nil {
+			return processor.Options{}, err
+		}
+		relatives = append(relatives, relative)
+	}
+	return processor.Options{
+		Policy:              datecalc.Policy{MonthEnd: monthEnd, LeapDay: leapDay},
+		Due:                 due,
+		AgeMode:             ageMode,
+		CheckupReminderDays: checkupReminderDays,
+		State:               state,
+		Country:             country,
+		GivenDoses:          givenDoses,
+		Planets:             planets,
+		Calendars:           calendarSystems,
+		Anchor:              anchor,
+		Species:             species,
+		Name:                getCleanName(query),
+		Relatives:           relatives,
+	}, nil
+}
+
+func handleCalendarRequest(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	query := r.URL.Query()
+
+	cleanName := getCleanName(query)
 
 	format := query.Get("format")
-	if format == "json" {
-		format = "json"
-	} else {
+	switch format {
+	case "json", "atom", "rss", "jsonfeed":
+	default:
 		format = "ical"
 	}
 
@@ -114,17 +344,32 @@ func handleCalendarRequest(w http.ResponseWriter, r *http.Request) {
 		includeEmoji = false
 	}
 
-	birth := time.Now()
-
 	var excludedCategories = getExcludedCategories(query)
 
+	opts, err := getOptions(query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	loc, err := getLocation(query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	birth := today(loc)
+
 	paramBirth := query.Get("birth")
 	if paramBirth != "" {
-		if parsedBirth, err := time.Parse("2006-01-02", paramBirth); err == nil {
+		if parsedBirth, err := time.ParseInLocation("2006-01-02", paramBirth, loc); err == nil {
 			birth = parsedBirth
 		} else {
 			fmt.Println("Invalid birth date format. Using current date.")
 		}
+	} else if !opts.Due.IsZero() {
+		// Vor der Geburt wird der Kalender der Schwangerschaft ab dem Termin erstellt
+		birth = opts.Due
+		opts.Unborn = true
 	} else {
 		fmt.Println("Birth parameter not provided. Using current date.")
 	}
@@ -132,7 +377,21 @@ func handleCalendarRequest(w http.ResponseWriter, r *http.Request) {
 	dateStr := birth.Format("2006-01-02")
 	dateNow := time.Now().Format("2006-01-02 15:04:05")
 
-	cachePath := cache.GenerateCacheFileName(birth, version, excludedCategories, cleanName, includeEmoji, format)
+	// Feeds hängen vom Abrufdatum ab und werden deshalb nur für den aktuellen Tag gecacht
+	now := time.Now()
+	cacheExtra := append(opts.Fingerprint(), getLocationFingerprint(loc)...)
+	// Geänderte Regeln für Kita und Schule oder Altersgrenzen ergeben neue Einträge
+	if opts.State != "" {
+		cacheExtra = append(cacheExtra, fmt.Sprintf("school-%s", dataSets.School.Version))
+	}
+	if opts.Country != "" {
+		cacheExtra = append(cacheExtra, fmt.Sprintf("legal-%s", dataSets.Legal.Version))
+	}
+	if isFeedFormat(format) {
+		cacheExtra = append(cacheExtra, now.In(loc).Format("2006-01-02"))
+	}
+
+	cachePath := cache.GenerateCacheFileName(birth, version, excludedCategories, cleanName, includeEmoji, format, cacheExtra...)
 	// fmt.Printf("Cache path: %s (Length: %d)\n", cachePath, len(cachePath))
 
 	// 3. Prüfen, ob bereits eine Cache-Datei für das aktuelle Datum existiert
@@ -153,7 +412,7 @@ func handleCalendarRequest(w http.ResponseWriter, r *http.Request) {
 	fmt.Printf("%s: Kein gültiger Cache gefunden. Berechne neue Ergebnisse für %s im Format %s.\n", dateNow, dateStr, format)
 
 	// 6. Berechnung der neuen Daten durchführen
-	results := processor.CalculateResults(timePeriods, birth, excludedCategories)
+	results := processor.CalculateResults(dataSets, birth, excludedCategories, opts)
 	// display.DisplayResults(results)
 
 	// Je nach Format die Antwort generieren
@@ -177,8 +436,24 @@ func handleCalendarRequest(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+	case "atom", "rss", "jsonfeed":
+		// Feed mit den letzten und nächsten Meilensteinen erstellen
+		recent, upcoming := processor.SplitFeedResults(results, now, feedRecentItems, feedUpcomingItems)
+		switch format {
+		case "atom":
+			responseData, err = output.GenerateAtomFeed(recent, upcoming, birth, cleanName, includeEmoji)
+		case "rss":
+			responseData, err = output.GenerateRSSFeed(recent, upcoming, birth, cleanName, includeEmoji)
+		default:
+			responseData, err = output.GenerateJSONFeed(recent, upcoming, birth, cleanName, includeEmoji)
+		}
+		if err != nil {
+			http.Error(w, "Error generating feed", http.StatusInternalServerError)
+			return
+		}
+
 	default:
-		http.Error(w, "Unsupported format. Use 'json' or 'ical'.", http.StatusBadRequest)
+		http.Error(w, "Unsupported format. Use 'json', 'ical', 'atom', 'rss' or 'jsonfeed'.", http.StatusBadRequest)
 		return
 	}
 
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"net/url"
+	"slices"
+	"testing"
+)
+
+func TestGetExcludedCategoriesBirthdays(t *testing.T) {
+	tests := []struct {
+		query    string
+		excluded bool
+	}{
+		{"", true},
+		{"include-birthdays", false},
+		{"exclude-birthdays", true},
+		// Außer bei der Geburt sind die Jahrestage standardmäßig sichtbar
+		{"anchor=wedding", false},
+		{"anchor=pet", false},
+		// Ein ausdrückliches Ausblenden hat Vorrang vor dem Standard des Ankerereignisses
+		{"anchor=wedding&exclude-birthdays", true},
+		{"anchor=pet&include-birthdays&exclude-birthdays", true},
+	}
+	for _, tt := range tests {
+		query, err := url.ParseQuery(tt.query)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := slices.Contains(getExcludedCategories(query), "birthday"); got != tt.excluded {
+			t.Errorf("%q: birthday excluded = %v, want %v", tt.query, got, tt.excluded)
+		}
+	}
+}
@@ -10,8 +10,25 @@ type TimePeriod struct {
 	Values     [4]int   `json:"values"`
 	Categories []string `json:"categories"`
 	Emoji      string   `json:"emoji,omitempty"`
+	Label      string   `json:"label,omitempty"` // Ersetzt die formatierte Zeitspanne, z.B. "SSW 12"
+	// Optionales Ende mehrtägiger Einträge, entweder als Periode ab dem Ankerdatum (End) oder als
+	// Dauer ab dem Beginn (Duration). Das Ende ist jeweils exklusiv.
+	End      *[4]int `json:"end,omitempty"`
+	Duration *[4]int `json:"duration,omitempty"`
 }
 
+// AnchorType ist die Art des Ereignisses, ab dem gezählt wird. Der Nullwert ist die Geburt.
+type AnchorType string
+
+const (
+	AnchorBirth        AnchorType = ""
+	AnchorAdoption     AnchorType = "adoption"     // Ankunft in der Familie (Gotcha Day)
+	AnchorWedding      AnchorType = "wedding"      // Hochzeit
+	AnchorRelationship AnchorType = "relationship" // Beginn einer Beziehung
+	AnchorPet          AnchorType = "pet"          // Geburt eines Haustiers
+	AnchorCustom       AnchorType = "custom"       // Beliebiges Ereignis, z.B. ein Umzug
+)
+
 // ResultEntry enthält die ursprünglichen Werte und das berechnete Datum
 type ResultEntry struct {
 	OriginalValues      TimePeriod `json:"original_values"`
@@ -22,6 +39,10 @@ type ResultEntry struct {
 	DaysBetween         int        `json:"days_between"`
 	Emoji               string     `json:"emoji"`
 	Categories          []string   `json:"categories"`
+	Notes               []string   `json:"notes,omitempty"`
+	Description         string     `json:"description,omitempty"` // Ersetzt die Standardbeschreibung, falls gesetzt
+	EndDate             time.Time  `json:"-"`                     // Letzter Tag mehrtägiger Einträge, sonst Nullwert. Ausgabe über ResultEntryJSON
+	Anchor              AnchorType `json:"anchor,omitempty"`      // Art des Ankerereignisses für die Wortwahl
 }
 
 type ResultEntryJSON struct {
@@ -33,6 +54,70 @@ type ResultEntryJSON struct {
 	DaysBetween         int    `json:"days_between"`
 	Summary             string `json:"summary"`
 	Description         string `json:"description"`
+	EndDate             string `json:"end_date,omitempty"`
+	FormattedEndDate    string `json:"formatted_end_date,omitempty"`
+}
+
+// Checkup beschreibt eine Vorsorgeuntersuchung mit empfohlenem Zeitraum und Toleranzgrenzen.
+// Die Grenzen sind Perioden ab der Geburt [Jahr, Monat, Woche, Tag], das Ende ist jeweils exklusiv.
+type Checkup struct {
+	Name          string `json:"name"`
+	Title         string `json:"title"`
+	Window        string `json:"window"` // Lesbare Angabe des Zeitraums, z.B. "im 3.–4. Lebensmonat"
+	From          [4]int `json:"from"`
+	To            [4]int `json:"to"`
+	ToleranceFrom [4]int `json:"tolerance_from"`
+	ToleranceTo   [4]int `json:"tolerance_to"`
+	Reminder      bool   `json:"reminder"` // Erinnerung zur Terminvereinbarung sinnvoll
+}
+
+// Vaccination ist eine Impfdosis nach den Empfehlungen der STIKO. Alter und Mindestabstand zur
+// vorherigen Dosis desselben Impfstoffs sind Perioden [Jahr, Monat, Woche, Tag].
+type Vaccination struct {
+	ID          string `json:"id"`       // Kurzzeichen des Impfstoffs für Parameter, z.B. "6fach"
+	Name        string `json:"name"`     // Kurzname für die Zusammenfassung
+	Diseases    string `json:"diseases"` // Erkrankungen, gegen die geimpft wird
+	Dose        int    `json:"dose"`
+	Age         [4]int `json:"age"`
+	MinInterval [4]int `json:"min_interval"`
+}
+
+// SchoolRules enthält die Regeln für Kita und Schule. Version gibt den Stand der Regeln an,
+// damit sie unabhängig von den Meilensteinen aktualisiert werden können.
+type SchoolRules struct {
+	Version    string                 `json:"version"`
+	KitaClaims []KitaClaim            `json:"kita_claims"`
+	States     map[string]StateSchool `json:"states"` // Schlüssel ist das Kürzel des Bundeslands, z.B. "BY"
+}
+
+// KitaClaim ist ein Rechtsanspruch auf Kinderbetreuung ab einem Alter [Jahr, Monat, Woche, Tag]
+type KitaClaim struct {
+	ID     string `json:"id"`
+	Label  string `json:"label"`
+	Age    [4]int `json:"age"`
+	Source string `json:"source"`
+}
+
+// StateSchool beschreibt die Einschulung in einem Bundesland. Datumsangaben sind im Format MM-DD.
+type StateSchool struct {
+	Name            string `json:"name"`
+	Cutoff          string `json:"cutoff"`            // Stichtag, bis zu dem das Kind sechs Jahre alt sein muss
+	SchoolYearStart string `json:"school_year_start"` // Beginn des Schuljahrs
+	Grades          int    `json:"grades"`            // Klassen bis zum Abitur am Gymnasium
+	Note            string `json:"note,omitempty"`
+}
+
+// LegalRules enthält rechtliche Altersgrenzen je Land. Version gibt den Stand der Daten an,
+// damit sie unabhängig von den Meilensteinen aktualisiert werden können.
+type LegalRules struct {
+	Version   string                  `json:"version"`
+	Countries map[string]LegalCountry `json:"countries"` // Schlüssel ist der Ländercode, z.B. "DE"
+}
+
+// LegalCountry enthält die Altersgrenzen eines Landes als Zeitperioden ab der Geburt
+type LegalCountry struct {
+	Name       string       `json:"name"`
+	Milestones []TimePeriod `json:"milestones"`
 }
 
 // CachedResults enthält die Metadaten und Ergebnisse
@@ -43,3 +128,105 @@ type CachedResultsJSON struct {
 	ExcludedCategories []string          `json:"excluded_categories"`
 	Results            []ResultEntryJSON `json:"results"`
 }
+
+// UpcomingEntryJSON ergänzt einen Eintrag um die verbleibenden Tage ab dem Abfragedatum
+type UpcomingEntryJSON struct {
+	ResultEntryJSON
+	DaysRemaining int `json:"days_remaining"`
+}
+
+// UpcomingJSON ist die Antwort des /upcoming-Endpunkts
+type UpcomingJSON struct {
+	BasedOnDate string              `json:"based_on_date"`
+	Date        string              `json:"date"`
+	Name        string              `json:"name"`
+	Results     []UpcomingEntryJSON `json:"results"`
+}
+
+// TodayJSON ist die Antwort des /today-Endpunkts
+type TodayJSON struct {
+	BasedOnDate string             `json:"based_on_date"`
+	Date        string             `json:"date"`
+	Name        string             `json:"name"`
+	IsSpecial   bool               `json:"is_special"`
+	Results     []ResultEntryJSON  `json:"results"`
+	Next        *UpcomingEntryJSON `json:"next,omitempty"`
+}
+
+// Age enthält das Alter an einem Datum, aufgeschlüsselt und als Gesamtwerte
+type Age struct {
+	Years       int    `json:"years"`
+	Months      int    `json:"months"`
+	Weeks       int    `json:"weeks"`
+	Days        int    `json:"days"`
+	TotalDays   int    `json:"total_days"`
+	TotalWeeks  int    `json:"total_weeks"`
+	TotalMonths int    `json:"total_months"`
+	TotalHours  int    `json:"total_hours"`
+	Formatted   string `json:"formatted"`
+	// Alter auf den ausgewählten Planeten, nur mit dem Parameter planets
+	Planets []PlanetAge `json:"planets,omitempty"`
+}
+
+// PlanetAge ist das Alter in Jahren eines anderen Planeten
+type PlanetAge struct {
+	Planet         string  `json:"planet"`
+	Name           string  `json:"name"`
+	Years          float64 `json:"years"`
+	CompletedYears int     `json:"completed_years"`
+	NextBirthday   string  `json:"next_birthday"`
+	Formatted      string  `json:"formatted"`
+}
+
+// AgeJSON ist die Antwort des /age-Endpunkts
+type AgeJSON struct {
+	BasedOnDate string             `json:"based_on_date"`
+	Date        string             `json:"date"`
+	Name        string             `json:"name"`
+	Age         Age                `json:"age"`
+	Previous    *UpcomingEntryJSON `json:"previous,omitempty"`
+	Next        *UpcomingEntryJSON `json:"next,omitempty"`
+}
+
+// Person ist ein Kind (oder eine andere Person) mit Namen und Geburtsdatum
+type Person struct {
+	Name  string
+	Birth time.Time
+	Role  string // Rolle in der Familie, z.B. "girl" oder "mother", leer bei Kindern ohne Angabe
+}
+
+// LookupResult ordnet ein Ergebnis der Person zu, zu der es gehört
+type LookupResult struct {
+	Person Person
+	// Position der Person in der Abfrage, -1 bei Einträgen mehrerer Personen wie dem
+	// gemeinsamen Alter
+	PersonIndex int
+	Result      ResultEntry
+}
+
+// LookupDay fasst alle Ergebnisse eines Datums zusammen
+type LookupDay struct {
+	Date    time.Time
+	Results []LookupResult
+	Shared  bool // Mehrere Personen haben an diesem Tag einen Meilenstein
+}
+
+type LookupResultJSON struct {
+	ResultEntryJSON
+	Name        string `json:"name"`
+	BasedOnDate string `json:"based_on_date"`
+}
+
+type LookupDayJSON struct {
+	Date    string             `json:"date"`
+	Shared  bool               `json:"shared"`
+	Results []LookupResultJSON `json:"results"`
+}
+
+// LookupJSON ist die Antwort des /lookup-Endpunkts
+type LookupJSON struct {
+	From        string          `json:"from"`
+	To          string          `json:"to"`
+	SharedDates []string        `json:"shared_dates"`
+	Days        []LookupDayJSON `json:"days"`
+}
@@ -0,0 +1,237 @@
+package output
+
+import (
+	"baby-calendar/display"
+	"baby-calendar/models"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+const siteURL = "https://baby-calendar.jonasparnow.com/"
+
+// feedItem ist die formatunabhängige Darstellung eines Feed-Eintrags
+type feedItem struct {
+	ID      string
+	Title   string
+	Content string
+	Date    time.Time
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"feed"`
+	Xmlns   string      `xml:"xmlns,attr"`
+	Lang    string      `xml:"xml:lang,attr"`
+	Title   string      `xml:"title"`
+	ID      string      `xml:"id"`
+	Updated string      `xml:"updated"`
+	Link    atomLink    `xml:"link"`
+	Author  atomAuthor  `xml:"author"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomEntry struct {
+	Title     string      `xml:"title"`
+	ID        string      `xml:"id"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+	Content   atomContent `xml:"content"`
+}
+
+type atomContent struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type rssFeed struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	Language      string    `xml:"language"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+	Description string  `xml:"description"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url"`
+	Description string         `json:"description"`
+	Language    string         `json:"language"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string `json:"id"`
+	Title         string `json:"title"`
+	ContentText   string `json:"content_text"`
+	DatePublished string `json:"date_published"`
+}
+
+// getFeedID liefert eine stabile ID, die unabhängig von der Version gleich bleibt,
+// damit Feedreader bereits bekannte Einträge wiedererkennen
+func getFeedID(resultID string, birthDate time.Time) string {
+	base := fmt.Sprintf("tag:baby-calendar.jonasparnow.com,2025:%s", birthDate.Format("2006-01-02"))
+	if resultID == "" {
+		return base
+	}
+	return fmt.Sprintf("%s:%s", base, resultID)
+}
+
+func getFeedTitle(name string) string {
+	if name != "" {
+		return fmt.Sprintf("%s Meilensteine", name)
+	}
+	return "Baby Meilensteine"
+}
+
+// buildFeedItems wandelt die vergangenen und kommenden Meilensteine in Feed-Einträge um.
+// Kommende Meilensteine erscheinen als Vorschau mit eigener ID und dem Datum des letzten
+// vergangenen Meilensteins, damit sie erst bei Erreichen als neuer Eintrag auftauchen.
+func buildFeedItems(recent, upcoming []models.ResultEntry, birthDate time.Time, name string, includeEmoji bool) []feedItem {
+	var items []feedItem
+
+	previewDate := birthDate
+	if len(recent) > 0 {
+		previewDate = recent[0].ResultDate
+	}
+	for _, result := range upcoming {
+		items = append(items, feedItem{
+			ID:      getFeedID(result.ResultId+"-upcoming", birthDate),
+			Title:   fmt.Sprintf("Demnächst am %s: %s", result.FormattedDate, display.GetSummary(name, result.FormattedTimePeriod, includeEmoji, result.Emoji)),
+			Content: getDescription(name, result, birthDate),
+			Date:    previewDate,
+		})
+	}
+
+	for _, result := range recent {
+		items = append(items, feedItem{
+			ID:      getFeedID(result.ResultId, birthDate),
+			Title:   display.GetSummary(name, result.FormattedTimePeriod, includeEmoji, result.Emoji),
+			Content: getDescription(name, result, birthDate),
+			Date:    result.ResultDate,
+		})
+	}
+	return items
+}
+
+func getFeedUpdated(items []feedItem, birthDate time.Time) time.Time {
+	updated := birthDate
+	for _, item := range items {
+		if item.Date.After(updated) {
+			updated = item.Date
+		}
+	}
+	return updated
+}
+
+// GenerateAtomFeed erzeugt einen Atom-Feed mit den letzten und nächsten Meilensteinen
+func GenerateAtomFeed(recent, upcoming []models.ResultEntry, birthDate time.Time, name string, includeEmoji bool) ([]byte, error) {
+	items := buildFeedItems(recent, upcoming, birthDate, name, includeEmoji)
+
+	feed := atomFeed{
+		Xmlns:   "http://www.w3.org/2005/Atom",
+		Lang:    "de",
+		Title:   getFeedTitle(name),
+		ID:      getFeedID("", birthDate),
+		Updated: getFeedUpdated(items, birthDate).Format(time.RFC3339),
+		Link:    atomLink{Href: siteURL},
+		Author:  atomAuthor{Name: "Baby Kalender"},
+	}
+	for _, item := range items {
+		feed.Entries = append(feed.Entries, atomEntry{
+			Title:     item.Title,
+			ID:        item.ID,
+			Published: item.Date.Format(time.RFC3339),
+			Updated:   item.Date.Format(time.RFC3339),
+			Content:   atomContent{Type: "text", Value: item.Content},
+		})
+	}
+
+	data, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// GenerateRSSFeed erzeugt einen RSS-2.0-Feed mit den letzten und nächsten Meilensteinen
+func GenerateRSSFeed(recent, upcoming []models.ResultEntry, birthDate time.Time, name string, includeEmoji bool) ([]byte, error) {
+	items := buildFeedItems(recent, upcoming, birthDate, name, includeEmoji)
+
+	feed := rssFeed{
+		Version: "2.0",
+		Channel: rssChannel{
+			Title:         getFeedTitle(name),
+			Link:          siteURL,
+			Description:   fmt.Sprintf("Besondere Tage seit dem %s", birthDate.Format("02.01.2006")),
+			Language:      "de",
+			LastBuildDate: getFeedUpdated(items, birthDate).Format(time.RFC1123Z),
+		},
+	}
+	for _, item := range items {
+		feed.Channel.Items = append(feed.Channel.Items, rssItem{
+			Title:       item.Title,
+			GUID:        rssGUID{IsPermaLink: false, Value: item.ID},
+			PubDate:     item.Date.Format(time.RFC1123Z),
+			Description: item.Content,
+		})
+	}
+
+	data, err := xml.MarshalIndent(feed, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), data...), nil
+}
+
+// GenerateJSONFeed erzeugt einen JSON Feed (Version 1.1) mit den letzten und nächsten Meilensteinen
+func GenerateJSONFeed(recent, upcoming []models.ResultEntry, birthDate time.Time, name string, includeEmoji bool) ([]byte, error) {
+	items := buildFeedItems(recent, upcoming, birthDate, name, includeEmoji)
+
+	feed := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       getFeedTitle(name),
+		HomePageURL: siteURL,
+		Description: fmt.Sprintf("Besondere Tage seit dem %s", birthDate.Format("02.01.2006")),
+		Language:    "de",
+		Items:       []jsonFeedItem{},
+	}
+	for _, item := range items {
+		feed.Items = append(feed.Items, jsonFeedItem{
+			ID:            item.ID,
+			Title:         item.Title,
+			ContentText:   item.Content,
+			DatePublished: item.Date.Format(time.RFC3339),
+		})
+	}
+
+	return json.MarshalIndent(feed, "", "  ")
+}
@@ -0,0 +1,121 @@
+package output
+
+import (
+	"baby-calendar/models"
+	"encoding/json"
+	"encoding/xml"
+	"strings"
+	"testing"
+	"time"
+)
+
+func feedResults() (recent, upcoming []models.ResultEntry, birth time.Time) {
+	birth = time.Date(2025, time.April, 21, 0, 0, 0, 0, time.UTC)
+	entry := func(id, period string, date time.Time) models.ResultEntry {
+		return models.ResultEntry{
+			ResultId:            id,
+			ResultDate:          date,
+			FormattedDate:       date.Format("02.01.2006"),
+			FormattedTimePeriod: period,
+			Emoji:               "🎉",
+		}
+	}
+	recent = []models.ResultEntry{
+		entry("0-0-0-500", "500 Tage", time.Date(2026, time.September, 3, 0, 0, 0, 0, time.UTC)),
+		entry("0-6-0-0", "6 Monate", time.Date(2025, time.October, 21, 0, 0, 0, 0, time.UTC)),
+	}
+	upcoming = []models.ResultEntry{
+		entry("1-6-0-0", "1 Jahr und 6 Monate", time.Date(2026, time.October, 21, 0, 0, 0, 0, time.UTC)),
+	}
+	return recent, upcoming, birth
+}
+
+func TestGenerateAtomFeed(t *testing.T) {
+	recent, upcoming, birth := feedResults()
+	data, err := GenerateAtomFeed(recent, upcoming, birth, "Emil", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var feed atomFeed
+	if err := xml.Unmarshal(data, &feed); err != nil {
+		t.Fatalf("invalid XML: %v", err)
+	}
+	if feed.Title != "Emil Meilensteine" || feed.ID != "tag:baby-calendar.jonasparnow.com,2025:2025-04-21" {
+		t.Errorf("feed title %q, ID %q", feed.Title, feed.ID)
+	}
+	// Das neueste Ereignis bestimmt den Zeitpunkt der Aktualisierung
+	if feed.Updated != "2026-09-03T00:00:00Z" {
+		t.Errorf("updated = %q", feed.Updated)
+	}
+	if len(feed.Entries) != 3 {
+		t.Fatalf("got %d entries, want 3", len(feed.Entries))
+	}
+
+	// Die Vorschau hat eine eigene ID und das Datum des letzten vergangenen Meilensteins
+	preview := feed.Entries[0]
+	if preview.ID != "tag:baby-calendar.jonasparnow.com,2025:2025-04-21:1-6-0-0-upcoming" || preview.Published != "2026-09-03T00:00:00Z" {
+		t.Errorf("preview ID %q, published %q", preview.ID, preview.Published)
+	}
+	if !strings.HasPrefix(preview.Title, "Demnächst am 21.10.2026: ") {
+		t.Errorf("preview title = %q", preview.Title)
+	}
+	if got := feed.Entries[1]; got.ID != "tag:baby-calendar.jonasparnow.com,2025:2025-04-21:0-0-0-500" || got.Published != "2026-09-03T00:00:00Z" {
+		t.Errorf("entry ID %q, published %q", got.ID, got.Published)
+	}
+}
+
+func TestGenerateRSSFeed(t *testing.T) {
+	recent, upcoming, birth := feedResults()
+	data, err := GenerateRSSFeed(recent, upcoming, birth, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var feed rssFeed
+	if err := xml.Unmarshal(data, &feed); err != nil {
+		t.Fatalf("invalid XML: %v", err)
+	}
+	if feed.Version != "2.0" || feed.Channel.Title != "Baby Meilensteine" {
+		t.Errorf("version %q, title %q", feed.Version, feed.Channel.Title)
+	}
+	if len(feed.Channel.Items) != 3 {
+		t.Fatalf("got %d items, want 3", len(feed.Channel.Items))
+	}
+	item := feed.Channel.Items[2]
+	if item.GUID.IsPermaLink || item.GUID.Value != "tag:baby-calendar.jonasparnow.com,2025:2025-04-21:0-6-0-0" {
+		t.Errorf("guid = %+v", item.GUID)
+	}
+	if item.PubDate != "Tue, 21 Oct 2025 00:00:00 +0000" {
+		t.Errorf("pubDate = %q", item.PubDate)
+	}
+}
+
+func TestGenerateJSONFeed(t *testing.T) {
+	recent, upcoming, birth := feedResults()
+	data, err := GenerateJSONFeed(recent, upcoming, birth, "Emil", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var feed jsonFeed
+	if err := json.Unmarshal(data, &feed); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+	if feed.Version != "https://jsonfeed.org/version/1.1" || len(feed.Items) != 3 {
+		t.Errorf("version %q with %d items", feed.Version, len(feed.Items))
+	}
+	ids := map[string]bool{}
+	for _, item := range feed.Items {
+		if ids[item.ID] {
+			t.Errorf("duplicate item ID %q", item.ID)
+		}
+		ids[item.ID] = true
+	}
+
+	// Ohne Einträge ist items eine leere Liste, nicht null
+	data, err = GenerateJSONFeed(nil, nil, birth, "", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(data), `"items": []`) {
+		t.Errorf("empty feed = %s", data)
+	}
+}
@@ -0,0 +1,60 @@
+package output
+
+import (
+	"baby-calendar/display"
+	"baby-calendar/models"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// GenerateLookupJSON erstellt die Antwort mit allen Meilensteinen mehrerer Personen im Zeitraum
+func GenerateLookupJSON(from, to time.Time, days []models.LookupDay, includeEmoji bool) models.LookupJSON {
+	lookup := models.LookupJSON{
+		From:        from.Format("2006-01-02"),
+		To:          to.Format("2006-01-02"),
+		SharedDates: []string{},
+		Days:        []models.LookupDayJSON{},
+	}
+
+	for _, day := range days {
+		dayJSON := models.LookupDayJSON{
+			Date:   day.Date.Format("2006-01-02"),
+			Shared: day.Shared,
+		}
+		for _, lookupResult := range day.Results {
+			person := lookupResult.Person
+			dayJSON.Results = append(dayJSON.Results, models.LookupResultJSON{
+				ResultEntryJSON: getResultJSON(person.Birth, lookupResult.Result, person.Name, includeEmoji),
+				Name:            person.Name,
+				BasedOnDate:     person.Birth.Format("2006-01-02"),
+			})
+		}
+		if day.Shared {
+			lookup.SharedDates = append(lookup.SharedDates, dayJSON.Date)
+		}
+		lookup.Days = append(lookup.Days, dayJSON)
+	}
+	return lookup
+}
+
+// GenerateLookupText listet die Meilensteine je Datum auf und hebt gemeinsame Tage hervor
+func GenerateLookupText(from, to time.Time, days []models.LookupDay, includeEmoji bool) string {
+	if len(days) == 0 {
+		return fmt.Sprintf("Keine Meilensteine zwischen dem %s und dem %s.\n", from.Format("02.01.2006"), to.Format("02.01.2006"))
+	}
+
+	var lines []string
+	for _, day := range days {
+		header := day.Date.Format("02.01.2006")
+		if day.Shared {
+			header += " (gemeinsamer Tag)"
+		}
+		lines = append(lines, header+":")
+		for _, lookupResult := range day.Results {
+			result := lookupResult.Result
+			lines = append(lines, "  "+display.GetSummary(lookupResult.Person.Name, result.FormattedTimePeriod, includeEmoji, result.Emoji))
+		}
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
@@ -5,6 +5,7 @@ import (
 	"baby-calendar/models"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	ics "github.com/arran4/golang-ical"
@@ -18,6 +19,14 @@ func SetContentTypeByFormat(w http.ResponseWriter, format string) {
 	case "ical":
 		w.Header().Set("Content-Type", "text/calendar")
 		w.Header().Set("Content-Disposition", "attachment; filename=\"calendar.ics\"")
+	case "atom":
+		w.Header().Set("Content-Type", "application/atom+xml; charset=utf-8")
+	case "rss":
+		w.Header().Set("Content-Type", "application/rss+xml; charset=utf-8")
+	case "jsonfeed":
+		w.Header().Set("Content-Type", "application/feed+json")
+	case "text":
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
 	}
 }
 
@@ -54,14 +63,20 @@ func GenerateICalendar(results []models.ResultEntry, birthDate time.Time, name,
 		event.SetDtStampTime(time.Now())
 		event.SetModifiedAt(time.Now())
 
+		// Mehrtägige Einträge enden nach ihrem letzten Tag, DTEND ist exklusiv
+		lastDate := eventDate
+		if !result.EndDate.IsZero() {
+			lastDate = result.EndDate
+		}
+
 		startDate := eventDate.Format("20060102")
-		endDate := eventDate.AddDate(0, 0, 1).Format("20060102")
+		endDate := lastDate.AddDate(0, 0, 1).Format("20060102")
 
 		event.AddProperty("DTSTART", startDate)
 		event.AddProperty("DTSTART;VALUE=DATE", startDate)
 		event.AddProperty("DTEND;VALUE=DATE", endDate)
 		event.SetSummary(display.GetSummary(name, result.FormattedTimePeriod, includeEmoji, result.Emoji))
-		event.SetDescription(display.GetDescription(name, result.DaysBetween, birthDate))
+		event.SetDescription(getDescription(name, result, birthDate))
 	}
 
 	// iCalendar-Daten als String rendern
@@ -70,21 +85,40 @@ func GenerateICalendar(results []models.ResultEntry, birthDate time.Time, name,
 	return []byte(calData), nil
 }
 
+// getDescription liefert die Beschreibung eines Eintrags. Einträge mit eigener Beschreibung
+// (z.B. aus der Schwangerschaft) verwenden diese statt der Standardbeschreibung.
+func getDescription(name string, result models.ResultEntry, birthDate time.Time) string {
+	if result.Description != "" {
+		return strings.Join(append([]string{result.Description}, result.Notes...), "\n")
+	}
+	return display.GetDescription(result.Anchor, name, result.DaysBetween, birthDate, result.Notes)
+}
+
+// getResultJSON wandelt ein Ergebnis in die JSON-Darstellung um
+func getResultJSON(birth time.Time, result models.ResultEntry, name string, includeEmoji bool) models.ResultEntryJSON {
+	resultJSON := models.ResultEntryJSON{
+		OriginalValues:      result.OriginalValues.Values,
+		ResultDate:          result.ResultDate.Format("2006-01-02"),
+		FormattedDate:       result.FormattedDate,
+		ResultId:            result.ResultId,
+		FormattedTimePeriod: result.FormattedTimePeriod,
+		DaysBetween:         result.DaysBetween,
+		Summary:             display.GetSummary(name, result.FormattedTimePeriod, includeEmoji, result.Emoji),
+		Description:         getDescription(name, result, birth),
+	}
+	if !result.EndDate.IsZero() {
+		resultJSON.EndDate = result.EndDate.Format("2006-01-02")
+		resultJSON.FormattedEndDate = result.EndDate.Format("02.01.2006")
+	}
+	return resultJSON
+}
+
 // CreateCachedResults erstellt ein CachedResults-Objekt mit den aktuellen Daten
 func GenerateJSONList(birth time.Time, results []models.ResultEntry, name string, excludedCategories []string, includeEmoji bool) models.CachedResultsJSON {
 	var resultsJSON []models.ResultEntryJSON
 
 	for _, result := range results {
-		resultsJSON = append(resultsJSON, models.ResultEntryJSON{
-			OriginalValues:      result.OriginalValues.Values,
-			ResultDate:          result.ResultDate.Format("2006-01-02"),
-			FormattedDate:       result.FormattedDate,
-			ResultId:            result.ResultId,
-			FormattedTimePeriod: result.FormattedTimePeriod,
-			DaysBetween:         result.DaysBetween,
-			Summary:             display.GetSummary(name, result.FormattedTimePeriod, includeEmoji, result.Emoji),
-			Description:         display.GetDescription(name, result.DaysBetween, birth),
-		})
+		resultsJSON = append(resultsJSON, getResultJSON(birth, result, name, includeEmoji))
 	}
 
 	return models.CachedResultsJSON{
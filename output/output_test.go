@@ -0,0 +1,97 @@
+package output
+
+import (
+	"baby-calendar/models"
+	"baby-calendar/processor"
+	"strings"
+	"testing"
+	"time"
+)
+
+func loadDataSets(t *testing.T) processor.DataSets {
+	t.Helper()
+	timePeriods, err := processor.LoadTimePeriods("../data/periods.json")
+	if err != nil {
+		t.Fatalf("LoadTimePeriods: %v", err)
+	}
+	checkups, err := processor.LoadCheckups("../data/checkups.json")
+	if err != nil {
+		t.Fatalf("LoadCheckups: %v", err)
+	}
+	vaccinations, err := processor.LoadVaccinations("../data/vaccinations.json")
+	if err != nil {
+		t.Fatalf("LoadVaccinations: %v", err)
+	}
+	school, err := processor.LoadSchoolRules("../data/school.json")
+	if err != nil {
+		t.Fatalf("LoadSchoolRules: %v", err)
+	}
+	legal, err := processor.LoadLegalRules("../data/legal.json")
+	if err != nil {
+		t.Fatalf("LoadLegalRules: %v", err)
+	}
+	return processor.DataSets{TimePeriods: timePeriods, Checkups: checkups, Vaccinations: vaccinations, School: school, Legal: legal}
+}
+
+// Jede UID darf im Kalender nur einmal vorkommen, sonst zeigen Kalender-Apps nur einen der
+// Einträge an
+func TestGenerateICalendarUniqueUIDs(t *testing.T) {
+	data := loadDataSets(t)
+	birth := time.Date(2023, time.January, 1, 0, 0, 0, 0, time.UTC)
+	opts := processor.Options{
+		Due:                 time.Date(2023, time.February, 10, 0, 0, 0, 0, time.UTC),
+		AgeMode:             processor.AgeModeBoth,
+		CheckupReminderDays: 14,
+		State:               "BY",
+		Country:             "DE",
+		Planets:             []string{"mercury", "venus", "mars", "jupiter", "saturn"},
+		Calendars:           []string{"hebrew", "islamic", "chinese", "persian"},
+		Name:                "Ida",
+		Relatives:           []models.Person{{Name: "Emil", Birth: time.Date(2025, time.April, 21, 0, 0, 0, 0, time.UTC)}},
+	}
+	results := processor.CalculateResults(data, birth, nil, opts)
+
+	calendar, err := GenerateICalendar(results, birth, "Ida", "test", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	uids := map[string]bool{}
+	events := 0
+	// Lange Zeilen werden umbrochen und mit einem Leerzeichen fortgesetzt
+	unfolded := strings.NewReplacer("\r\n ", "", "\n ", "").Replace(string(calendar))
+	for _, line := range strings.Split(unfolded, "\n") {
+		line = strings.TrimSuffix(line, "\r")
+		uid, ok := strings.CutPrefix(line, "UID:")
+		if !ok {
+			continue
+		}
+		events++
+		if uids[uid] {
+			t.Errorf("duplicate UID %q", uid)
+		}
+		uids[uid] = true
+	}
+	if events != len(results) {
+		t.Errorf("got %d events, want %d", events, len(results))
+	}
+}
+
+func TestGenerateICalendarMultiDay(t *testing.T) {
+	birth := time.Date(2025, time.April, 21, 0, 0, 0, 0, time.UTC)
+	result := models.ResultEntry{
+		ResultId:            "checkup-U3",
+		ResultDate:          time.Date(2025, time.May, 12, 0, 0, 0, 0, time.UTC),
+		EndDate:             time.Date(2025, time.May, 25, 0, 0, 0, 0, time.UTC),
+		FormattedTimePeriod: "U3-Untersuchung",
+	}
+	data, err := GenerateICalendar([]models.ResultEntry{result}, birth, "", "test", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// DTEND ist exklusiv und liegt einen Tag nach dem letzten Tag
+	for _, want := range []string{"UID:checkup-U3-20250421-test", "DTSTART;VALUE=DATE:20250512", "DTEND;VALUE=DATE:20250526"} {
+		if !strings.Contains(string(data), want) {
+			t.Errorf("calendar does not contain %q", want)
+		}
+	}
+}
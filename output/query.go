@@ -0,0 +1,134 @@
+package output
+
+import (
+	"baby-calendar/display"
+	"baby-calendar/models"
+	"baby-calendar/processor"
+	"fmt"
+	"strings"
+	"time"
+)
+
+func getUpcomingJSON(birth, date time.Time, result models.ResultEntry, name string, includeEmoji bool) models.UpcomingEntryJSON {
+	return models.UpcomingEntryJSON{
+		ResultEntryJSON: getResultJSON(birth, result, name, includeEmoji),
+		DaysRemaining:   processor.DaysUntil(date, result.ResultDate),
+	}
+}
+
+func getUpcomingLine(date time.Time, result models.ResultEntry, name string, includeEmoji bool) string {
+	return fmt.Sprintf("%s (%s): %s",
+		result.FormattedDate,
+		display.GetDaysRemaining(processor.DaysUntil(date, result.ResultDate)),
+		display.GetSummary(name, result.FormattedTimePeriod, includeEmoji, result.Emoji),
+	)
+}
+
+// GenerateUpcomingJSON erstellt die Antwort mit den nächsten Meilensteinen ab date
+func GenerateUpcomingJSON(birth, date time.Time, upcoming []models.ResultEntry, name string, includeEmoji bool) models.UpcomingJSON {
+	resultsJSON := []models.UpcomingEntryJSON{}
+	for _, result := range upcoming {
+		resultsJSON = append(resultsJSON, getUpcomingJSON(birth, date, result, name, includeEmoji))
+	}
+
+	return models.UpcomingJSON{
+		BasedOnDate: birth.Format("2006-01-02"),
+		Date:        date.Format("2006-01-02"),
+		Name:        name,
+		Results:     resultsJSON,
+	}
+}
+
+// GenerateUpcomingText erstellt eine Textzeile je kommendem Meilenstein
+func GenerateUpcomingText(date time.Time, upcoming []models.ResultEntry, name string, includeEmoji bool) string {
+	if len(upcoming) == 0 {
+		return fmt.Sprintf("Keine Meilensteine ab dem %s.\n", date.Format("02.01.2006"))
+	}
+
+	lines := []string{fmt.Sprintf("Nächste Meilensteine ab dem %s:", date.Format("02.01.2006"))}
+	for _, result := range upcoming {
+		lines = append(lines, getUpcomingLine(date, result, name, includeEmoji))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// GenerateTodayJSON erstellt die Antwort mit den Meilensteinen am Datum date und dem nächsten danach
+func GenerateTodayJSON(birth, date time.Time, matches, upcoming []models.ResultEntry, name string, includeEmoji bool) models.TodayJSON {
+	resultsJSON := []models.ResultEntryJSON{}
+	for _, result := range matches {
+		resultsJSON = append(resultsJSON, getResultJSON(birth, result, name, includeEmoji))
+	}
+
+	today := models.TodayJSON{
+		BasedOnDate: birth.Format("2006-01-02"),
+		Date:        date.Format("2006-01-02"),
+		Name:        name,
+		IsSpecial:   len(matches) > 0,
+		Results:     resultsJSON,
+	}
+	if len(upcoming) > 0 {
+		next := getUpcomingJSON(birth, date, upcoming[0], name, includeEmoji)
+		today.Next = &next
+	}
+	return today
+}
+
+// GenerateTodayText beschreibt die Meilensteine am Datum date und den nächsten danach
+func GenerateTodayText(date time.Time, matches, upcoming []models.ResultEntry, name string, includeEmoji bool) string {
+	var lines []string
+	if len(matches) == 0 {
+		lines = append(lines, fmt.Sprintf("Der %s ist kein besonderer Tag.", date.Format("02.01.2006")))
+	} else {
+		lines = append(lines, fmt.Sprintf("Der %s ist ein besonderer Tag:", date.Format("02.01.2006")))
+		for _, result := range matches {
+			lines = append(lines, display.GetSummary(name, result.FormattedTimePeriod, includeEmoji, result.Emoji))
+		}
+	}
+	if len(upcoming) > 0 {
+		lines = append(lines, fmt.Sprintf("Nächster Meilenstein: %s", getUpcomingLine(date, upcoming[0], name, includeEmoji)))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// GenerateAgeJSON erstellt die Antwort mit dem Alter am Datum date und den angrenzenden Meilensteinen
+func GenerateAgeJSON(birth, date time.Time, age models.Age, previous, next *models.ResultEntry, name string, includeEmoji bool) models.AgeJSON {
+	ageJSON := models.AgeJSON{
+		BasedOnDate: birth.Format("2006-01-02"),
+		Date:        date.Format("2006-01-02"),
+		Name:        name,
+		Age:         age,
+	}
+	if previous != nil {
+		previousJSON := getUpcomingJSON(birth, date, *previous, name, includeEmoji)
+		ageJSON.Previous = &previousJSON
+	}
+	if next != nil {
+		nextJSON := getUpcomingJSON(birth, date, *next, name, includeEmoji)
+		ageJSON.Next = &nextJSON
+	}
+	return ageJSON
+}
+
+// GenerateAgeText beschreibt das Alter am Datum date und die angrenzenden Meilensteine
+func GenerateAgeText(date time.Time, age models.Age, previous, next *models.ResultEntry, name string, includeEmoji bool) string {
+	subject := "Das Alter"
+	if name != "" {
+		subject = fmt.Sprintf("Das Alter von %s", name)
+	}
+
+	lines := []string{
+		fmt.Sprintf("%s am %s: %s", subject, date.Format("02.01.2006"), age.Formatted),
+		fmt.Sprintf("Insgesamt %d Tage, %d Wochen, %d Monate oder %d Stunden.", age.TotalDays, age.TotalWeeks, age.TotalMonths, age.TotalHours),
+	}
+	for _, planet := range age.Planets {
+		nextBirthday, _ := time.Parse("2006-01-02", planet.NextBirthday)
+		lines = append(lines, fmt.Sprintf("Auf dem Planeten %s: %s, nächster Geburtstag am %s.", planet.Name, planet.Formatted, nextBirthday.Format("02.01.2006")))
+	}
+	if previous != nil {
+		lines = append(lines, fmt.Sprintf("Letzter Meilenstein: %s: %s", previous.FormattedDate, display.GetSummary(name, previous.FormattedTimePeriod, includeEmoji, previous.Emoji)))
+	}
+	if next != nil {
+		lines = append(lines, fmt.Sprintf("Nächster Meilenstein: %s", getUpcomingLine(date, *next, name, includeEmoji)))
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
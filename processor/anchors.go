@@ -0,0 +1,44 @@
+package processor
+
+import (
+	"baby-calendar/models"
+	"fmt"
+)
+
+// Kategorien, die sich nur auf die Geburt eines Kindes beziehen und bei anderen
+// Ankerereignissen immer ausgeblendet werden
+var birthOnlyCategories = []string{
+	categoryPregnancy,
+	"phases",
+	categoryParental,
+	categoryCheckups,
+	categoryVaccinations,
+	categorySchool,
+	categoryLegal,
+}
+
+// ParseAnchorType liest die Art des Ankerereignisses aus einem Parameterwert
+func ParseAnchorType(value string) (models.AnchorType, error) {
+	switch anchor := models.AnchorType(value); anchor {
+	case "birth":
+		return models.AnchorBirth, nil
+	case models.AnchorBirth, models.AnchorAdoption, models.AnchorWedding, models.AnchorRelationship, models.AnchorPet, models.AnchorCustom:
+		return anchor, nil
+	}
+	return "", fmt.Errorf("Invalid anchor value %q. Use birth, adoption, wedding, relationship, pet or custom.", value)
+}
+
+// anchorHasAge prüft, ob das Ankerereignis eine Geburt ist, ab der ein Alter gezählt wird
+func anchorHasAge(anchor models.AnchorType) bool {
+	return anchor == models.AnchorBirth || anchor == models.AnchorPet
+}
+
+// AnchorCategories liefert die Kategorien, die bei einer Art von Ankerereignis abweichend vom
+// Standard angezeigt (included) oder immer ausgeblendet (excluded) werden. Außer bei der Geburt
+// sind die Jahrestage standardmäßig sichtbar, solange sie nicht ausdrücklich ausgeblendet werden.
+func AnchorCategories(anchor models.AnchorType) (included, excluded []string) {
+	if anchor == models.AnchorBirth {
+		return nil, nil
+	}
+	return []string{"birthday"}, birthOnlyCategories
+}
@@ -0,0 +1,98 @@
+package processor
+
+import (
+	"baby-calendar/calendars"
+	"baby-calendar/display"
+	"baby-calendar/models"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Kategorie der Geburtstage in anderen Kalendersystemen
+const categoryCalendars = "calendars"
+
+// Geburtstage in anderen Kalendern werden bis zu diesem Alter in Erdjahren berechnet
+const maxCalendarAgeYears = 100
+
+// Hinweise zu einzelnen Kalendern
+var calendarNotes = map[string]string{
+	"islamic": "Berechnet nach dem tabellarischen Kalender, der beobachtete Kalender kann um ein bis zwei Tage abweichen.",
+}
+
+// ParseCalendars liest die Kalendersysteme aus einem Parameterwert mit Kommas. Ein leerer Wert
+// wählt alle Kalender.
+func ParseCalendars(value string) ([]string, error) {
+	ids := calendars.IDs()
+	if value == "" {
+		return ids, nil
+	}
+
+	var selected []string
+	for _, id := range strings.Split(strings.ToLower(value), ",") {
+		if !slices.Contains(ids, id) {
+			return nil, fmt.Errorf("Invalid calendars value %q. Use a comma-separated list of %s.", value, strings.Join(ids, ", "))
+		}
+		if !slices.Contains(selected, id) {
+			selected = append(selected, id)
+		}
+	}
+	return selected, nil
+}
+
+// calculateCalendarResults berechnet für die Kalender in den Optionen jeden Geburtstag nach
+// dem jeweiligen Kalender. Außerhalb des unterstützten Bereichs eines Kalenders entfallen die
+// Einträge.
+func calculateCalendarResults(birth time.Time, excludedCategories []string, opts Options) []models.ResultEntry {
+	if slices.Contains(excludedCategories, categoryCalendars) {
+		return nil
+	}
+
+	limit := birth.AddDate(maxCalendarAgeYears, 0, 0)
+	var results []models.ResultEntry
+	for _, id := range opts.Calendars {
+		calendar, ok := calendars.Get(id)
+		if !ok {
+			continue
+		}
+		birthDate, ok := calendar.FromTime(birth)
+		if !ok {
+			continue
+		}
+
+		for years := 1; ; years++ {
+			anniversary := calendar.Anniversary(birthDate, birthDate.Year+years)
+			date, ok := calendar.ToTime(anniversary, birth.Location())
+			if !ok || date.After(limit) {
+				break
+			}
+
+			missingDate := ""
+			if anniversary.Day != birthDate.Day {
+				missingDate = calendar.Format(calendars.Date{Year: anniversary.Year, Month: anniversary.Month, Day: birthDate.Day, Leap: anniversary.Leap})
+			}
+			notes := []string{display.GetCalendarDescription(opts.Anchor, calendar.Name(), calendar.Format(birthDate), calendar.Format(anniversary), missingDate)}
+			if note, ok := calendarNotes[id]; ok {
+				notes = append(notes, note)
+			}
+
+			results = append(results, models.ResultEntry{
+				OriginalValues: models.TimePeriod{
+					Values:     [4]int{years, 0, 0, 0},
+					Categories: []string{categoryCalendars},
+					Emoji:      "🗓️",
+				},
+				ResultDate:          date,
+				FormattedDate:       date.Format("02.01.2006"),
+				ResultId:            fmt.Sprintf("calendar-%s-%d", id, years),
+				FormattedTimePeriod: fmt.Sprintf("%s (%ser Kalender)", FormatTimePeriod(years, 0, 0, 0), calendar.Name()),
+				DaysBetween:         daysBetween(birth, date),
+				Emoji:               "🗓️",
+				Categories:          []string{categoryCalendars},
+				Notes:               notes,
+			})
+		}
+	}
+	return results
+}
@@ -0,0 +1,57 @@
+package processor
+
+import (
+	"baby-calendar/models"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseCalendars(t *testing.T) {
+	if got, err := ParseCalendars(""); err != nil || !slices.Equal(got, []string{"hebrew", "islamic", "chinese", "persian"}) {
+		t.Errorf("ParseCalendars(\"\") = %v, %v", got, err)
+	}
+	if got, err := ParseCalendars("Persian,hebrew,persian"); err != nil || !slices.Equal(got, []string{"persian", "hebrew"}) {
+		t.Errorf("ParseCalendars = %v, %v", got, err)
+	}
+	if _, err := ParseCalendars("julian"); err == nil {
+		t.Error("unknown calendar accepted")
+	}
+}
+
+func TestCalculateCalendarResults(t *testing.T) {
+	tests := []struct {
+		birth time.Time
+		id    string
+		want  time.Time
+	}{
+		// Geboren an Rosch ha-Schana 5785, der erste hebräische Geburtstag ist Rosch ha-Schana 5786
+		{date(2024, time.October, 3), "calendar-hebrew-1", date(2025, time.September, 23)},
+		// Geboren an Nowruz 1403, der erste persische Geburtstag ist Nowruz 1404
+		{date(2024, time.March, 20), "calendar-persian-1", date(2025, time.March, 21)},
+		// Geboren am Neujahrsfest 2024
+		{date(2024, time.February, 10), "calendar-chinese-1", date(2025, time.January, 29)},
+	}
+	for _, tt := range tests {
+		results := calculateCalendarResults(tt.birth, nil, Options{Calendars: []string{"hebrew", "persian", "chinese"}})
+		index := slices.IndexFunc(results, func(result models.ResultEntry) bool { return result.ResultId == tt.id })
+		if index < 0 {
+			t.Errorf("missing %s", tt.id)
+			continue
+		}
+		if got := results[index].ResultDate; !got.Equal(tt.want) {
+			t.Errorf("%s = %s, want %s", tt.id, got.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+		}
+	}
+
+	// Der 30. Esfand 1403 fehlt 1404, der Eintrag erklärt den Ersatztag
+	results := calculateCalendarResults(date(2025, time.March, 20), nil, Options{Calendars: []string{"persian"}})
+	if len(results) == 0 || !results[0].ResultDate.Equal(date(2026, time.March, 20)) || !strings.Contains(results[0].Notes[0], "30. Esfand 1404") {
+		t.Errorf("first persian birthday = %+v", results[0])
+	}
+
+	if results := calculateCalendarResults(date(2024, time.October, 3), []string{categoryCalendars}, Options{Calendars: []string{"hebrew"}}); results != nil {
+		t.Errorf("excluded calendars category returned %d results", len(results))
+	}
+}
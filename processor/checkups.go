@@ -0,0 +1,122 @@
+package processor
+
+import (
+	"baby-calendar/display"
+	"baby-calendar/models"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"time"
+)
+
+// Kategorie der Vorsorgeuntersuchungen (U1–U9, J1) und der Erinnerungen an die Terminvereinbarung
+const categoryCheckups = "medical-checkups"
+
+// Vorlauf der Erinnerung an die Terminvereinbarung, wenn kein Wert angegeben ist
+const DefaultCheckupReminderDays = 14
+
+// Höchster erlaubter Vorlauf der Erinnerung in Tagen
+const maxCheckupReminderDays = 180
+
+// ParseCheckupReminderDays liest den Vorlauf der Erinnerung aus einem Parameterwert. Ein leerer
+// Wert ergibt den Standardvorlauf.
+func ParseCheckupReminderDays(value string) (int, error) {
+	if value == "" {
+		return DefaultCheckupReminderDays, nil
+	}
+	days, err := strconv.Atoi(value)
+	if err != nil || days < 1 || days > maxCheckupReminderDays {
+		return 0, fmt.Errorf("Invalid checkup-reminder value %q. Use a number of days between 1 and %d.", value, maxCheckupReminderDays)
+	}
+	return days, nil
+}
+
+// LoadCheckups lädt die Zeiträume der Vorsorgeuntersuchungen aus der JSON-Datei
+func LoadCheckups(filePath string) ([]models.Checkup, error) {
+	byteValue, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Lesen der Datei: %w", err)
+	}
+
+	var checkups []models.Checkup
+	if err := json.Unmarshal(byteValue, &checkups); err != nil {
+		return nil, fmt.Errorf("Fehler beim Unmarshalling des JSON: %w", err)
+	}
+
+	for index, checkup := range checkups {
+		if checkup.Name == "" {
+			return nil, fmt.Errorf("Fehler in Eintrag %d: Name fehlt", index+1)
+		}
+		if hasMixedSigns(checkup.From) || hasMixedSigns(checkup.To) || hasMixedSigns(checkup.ToleranceFrom) || hasMixedSigns(checkup.ToleranceTo) {
+			return nil, fmt.Errorf("Fehler in Eintrag %d: Werte von %s haben unterschiedliche Vorzeichen", index+1, checkup.Name)
+		}
+	}
+	return checkups, nil
+}
+
+// calculateCheckupResults berechnet die Zeiträume der Vorsorgeuntersuchungen als mehrtägige
+// Einträge vom ersten bis zum letzten Tag des empfohlenen Zeitraums. Mit einem Vorlauf in den
+// Optionen kommt vor jedem Zeitraum eine Erinnerung an die Terminvereinbarung hinzu.
+func calculateCheckupResults(checkups []models.Checkup, birth time.Time, excludedCategories []string, opts Options) []models.ResultEntry {
+	if slices.Contains(excludedCategories, categoryCheckups) {
+		return nil
+	}
+
+	var results []models.ResultEntry
+	for _, checkup := range checkups {
+		start := addPeriodValues(birth, checkup.From, opts)
+		// Das Ende ist exklusiv, der letzte Tag liegt einen Tag davor
+		end := addPeriodValues(birth, checkup.To, opts).AddDate(0, 0, -1)
+		if end.Before(start) {
+			end = start
+		}
+		toleranceStart := addPeriodValues(birth, checkup.ToleranceFrom, opts)
+		toleranceEnd := addPeriodValues(birth, checkup.ToleranceTo, opts).AddDate(0, 0, -1)
+
+		results = append(results, models.ResultEntry{
+			OriginalValues: models.TimePeriod{
+				Values:     checkup.From,
+				Categories: []string{categoryCheckups},
+				Emoji:      "🩺",
+				Label:      checkup.Name,
+			},
+			ResultDate:          start,
+			FormattedDate:       start.Format("02.01.2006"),
+			ResultId:            "checkup-" + checkup.Name,
+			FormattedTimePeriod: fmt.Sprintf("%s-Untersuchung", checkup.Name),
+			DaysBetween:         daysBetween(birth, start),
+			Emoji:               "🩺",
+			Categories:          []string{categoryCheckups},
+			Description:         display.GetCheckupDescription(checkup.Name, checkup.Title, checkup.Window, start, end, toleranceStart, toleranceEnd),
+			EndDate:             end,
+		})
+
+		if opts.CheckupReminderDays <= 0 || !checkup.Reminder {
+			continue
+		}
+		reminder := start.AddDate(0, 0, -opts.CheckupReminderDays)
+		// Vor der Geburt lässt sich noch kein Termin vereinbaren
+		if reminder.Before(birth) {
+			continue
+		}
+		results = append(results, models.ResultEntry{
+			OriginalValues: models.TimePeriod{
+				Values:     checkup.From,
+				Categories: []string{categoryCheckups},
+				Emoji:      "📞",
+				Label:      checkup.Name,
+			},
+			ResultDate:          reminder,
+			FormattedDate:       reminder.Format("02.01.2006"),
+			ResultId:            "checkup-" + checkup.Name + "-reminder",
+			FormattedTimePeriod: fmt.Sprintf("Termin für die %s vereinbaren", checkup.Name),
+			DaysBetween:         daysBetween(birth, reminder),
+			Emoji:               "📞",
+			Categories:          []string{categoryCheckups},
+			Description:         display.GetCheckupReminderDescription(checkup.Name, start, end),
+		})
+	}
+	return results
+}
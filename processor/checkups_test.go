@@ -0,0 +1,85 @@
+package processor
+
+import (
+	"baby-calendar/models"
+	"testing"
+	"time"
+)
+
+func TestParseCheckupReminderDays(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    int
+		wantErr bool
+	}{
+		{"", DefaultCheckupReminderDays, false},
+		{"7", 7, false},
+		{"180", 180, false},
+		{"0", 0, true},
+		{"181", 0, true},
+		{"zwei", 0, true},
+	}
+	for _, tt := range tests {
+		got, err := ParseCheckupReminderDays(tt.value)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseCheckupReminderDays(%q) = %d, %v, want %d, error %v", tt.value, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestCalculateCheckupResults(t *testing.T) {
+	checkups, err := LoadCheckups("../data/checkups.json")
+	if err != nil {
+		t.Fatalf("LoadCheckups: %v", err)
+	}
+	birth := date(2025, time.April, 21)
+
+	byID := map[string]models.ResultEntry{}
+	for _, result := range calculateCheckupResults(checkups, birth, nil, Options{CheckupReminderDays: 14}) {
+		byID[result.ResultId] = result
+	}
+
+	tests := []struct {
+		id         string
+		start, end time.Time
+	}{
+		// Das Ende ist exklusiv: die U1 dauert nur den Tag der Geburt
+		{"checkup-U1", date(2025, time.April, 21), date(2025, time.April, 21)},
+		{"checkup-U2", date(2025, time.April, 23), date(2025, time.April, 30)},
+		{"checkup-U3", date(2025, time.May, 12), date(2025, time.May, 25)},
+		{"checkup-U4", date(2025, time.June, 21), date(2025, time.August, 20)},
+		{"checkup-J1", date(2037, time.April, 21), date(2039, time.April, 20)},
+	}
+	for _, tt := range tests {
+		result, ok := byID[tt.id]
+		if !ok {
+			t.Errorf("missing %s", tt.id)
+			continue
+		}
+		if !result.ResultDate.Equal(tt.start) || !result.EndDate.Equal(tt.end) {
+			t.Errorf("%s = %s–%s, want %s–%s", tt.id, result.ResultDate.Format("2006-01-02"), result.EndDate.Format("2006-01-02"), tt.start.Format("2006-01-02"), tt.end.Format("2006-01-02"))
+		}
+	}
+
+	// Erinnerungen gibt es nur für Untersuchungen, für die ein Termin vereinbart wird
+	if reminder, ok := byID["checkup-U4-reminder"]; !ok || !reminder.ResultDate.Equal(date(2025, time.June, 7)) {
+		t.Errorf("checkup-U4-reminder = %s, want 2025-06-07", reminder.ResultDate.Format("2006-01-02"))
+	}
+	for _, id := range []string{"checkup-U1-reminder", "checkup-U2-reminder"} {
+		if _, ok := byID[id]; ok {
+			t.Errorf("unexpected %s", id)
+		}
+	}
+	// Die Erinnerung läge vor der Geburt
+	byID = map[string]models.ResultEntry{}
+	for _, result := range calculateCheckupResults(checkups, birth, nil, Options{CheckupReminderDays: 30}) {
+		byID[result.ResultId] = result
+	}
+	if _, ok := byID["checkup-U3-reminder"]; ok {
+		t.Error("checkup-U3-reminder before birth")
+	}
+
+	if results := calculateCheckupResults(checkups, birth, []string{categoryCheckups}, Options{}); results != nil {
+		t.Errorf("excluded checkups category returned %d results", len(results))
+	}
+}
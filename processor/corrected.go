@@ -0,0 +1,89 @@
+package processor
+
+import (
+	"baby-calendar/models"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// AgeMode legt fest, ob die Meilensteine nach dem tatsächlichen Alter, nach dem korrigierten
+// Alter (gezählt ab dem errechneten Termin) oder nach beiden berechnet werden
+type AgeMode string
+
+const (
+	// AgeModeAuto zeigt beide Reihen, wenn das Kind vor dem Termin geboren wurde, sonst nur
+	// das tatsächliche Alter
+	AgeModeAuto      AgeMode = ""
+	AgeModeActual    AgeMode = "actual"
+	AgeModeCorrected AgeMode = "corrected"
+	AgeModeBoth      AgeMode = "both"
+)
+
+// Kategorie der Einträge, die nach dem korrigierten Alter berechnet werden
+const categoryCorrected = "corrected"
+
+// Dauer einer Schwangerschaft bis zum errechneten Termin (40+0)
+const pregnancyDays = 280
+
+// ParseAgeMode liest die Altersreihe aus einem Parameterwert
+func ParseAgeMode(value string) (AgeMode, error) {
+	switch AgeMode(value) {
+	case AgeModeAuto, AgeModeActual, AgeModeCorrected, AgeModeBoth:
+		return AgeMode(value), nil
+	}
+	return "", fmt.Errorf("Invalid age value %q. Use actual, corrected or both.", value)
+}
+
+func (o Options) showsCorrectedAge(birth time.Time) bool {
+	if o.Due.IsZero() {
+		return false
+	}
+	switch o.AgeMode {
+	case AgeModeCorrected, AgeModeBoth:
+		return true
+	case AgeModeAuto:
+		return daysBetween(birth, o.Due) > 0
+	}
+	return false
+}
+
+func (o Options) showsActualAge(birth time.Time) bool {
+	return o.AgeMode != AgeModeCorrected || !o.showsCorrectedAge(birth)
+}
+
+// formatGestationalAge formatiert ein Schwangerschaftsalter in Tagen in der üblichen
+// Schreibweise Wochen+Tage, z.B. 31+4
+func formatGestationalAge(days int) string {
+	return fmt.Sprintf("%d+%d", days/7, days%7)
+}
+
+// correctedAgeNote beschreibt den Abstand zwischen Geburt und errechnetem Termin
+func correctedAgeNote(birth, due time.Time) string {
+	difference := daysBetween(birth, due)
+	note := fmt.Sprintf("Korrigiertes Alter, gezählt ab dem errechneten Termin am %s.", due.Format("02.01.2006"))
+
+	switch {
+	case difference > 0:
+		return fmt.Sprintf("%s Geboren in der SSW %s, %s vor dem Termin.", note, formatGestationalAge(pregnancyDays-difference), FormatTimePeriod(0, 0, difference/7, difference%7))
+	case difference < 0:
+		return fmt.Sprintf("%s Geboren in der SSW %s, %s nach dem Termin.", note, formatGestationalAge(pregnancyDays-difference), FormatTimePeriod(0, 0, -difference/7, -difference%7))
+	}
+	return fmt.Sprintf("%s Geboren am Termin.", note)
+}
+
+// calculateCorrectedResults berechnet die zweite Reihe der Meilensteine ab dem errechneten
+// Termin. Die Geburt selbst ist nicht Teil dieser Reihe.
+func calculateCorrectedResults(timePeriods []models.TimePeriod, birth time.Time, excludedCategories []string, opts Options) []models.ResultEntry {
+	due := inLocationOf(opts.Due, birth)
+	note := correctedAgeNote(birth, due)
+
+	results := calculatePeriodResults(timePeriods, due, birth, append(slices.Clone(excludedCategories), "birth"), opts)
+	for i := range results {
+		results[i].ResultId = "corrected-" + results[i].ResultId
+		results[i].FormattedTimePeriod = fmt.Sprintf("%s (korrigiert)", results[i].FormattedTimePeriod)
+		results[i].Categories = append(slices.Clone(results[i].Categories), categoryCorrected)
+		results[i].Notes = append(results[i].Notes, note)
+	}
+	return results
+}
@@ -0,0 +1,155 @@
+package processor
+
+import (
+	"baby-calendar/almanac"
+	"baby-calendar/models"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Kategorie der ersten Feste, Jahreszeiten und Zeitumstellungen
+const categoryFirsts = "firsts"
+
+// first ist ein erstes Ereignis nach der Geburt
+type first struct {
+	id      string
+	label   string
+	emoji   string
+	date    time.Time
+	endDate time.Time
+	note    string
+}
+
+// firstOccurrence liefert das erste Ereignis ab dem Geburtstag. date liefert das Ereignis in
+// einem Jahr.
+func firstOccurrence(birth time.Time, date func(year int) time.Time) time.Time {
+	occurrence := date(birth.Year())
+	if dateKey(occurrence) < dateKey(birth) {
+		occurrence = date(birth.Year() + 1)
+	}
+	return occurrence
+}
+
+// Jahreszeiten der Nordhalbkugel mit Bezeichnung, Emoji und astronomischem Ereignis
+var firstSeasons = []struct {
+	season almanac.Season
+	id     string
+	label  string
+	emoji  string
+	event  string
+}{
+	{almanac.MarchEquinox, "spring", "Erster Frühlingsanfang", "🌷", "Tagundnachtgleiche"},
+	{almanac.JuneSolstice, "summer", "Erster Sommeranfang", "☀️", "Sommersonnenwende"},
+	{almanac.SeptemberEquinox, "autumn", "Erster Herbstanfang", "🍂", "Tagundnachtgleiche"},
+	{almanac.DecemberSolstice, "winter", "Erster Winteranfang", "❄️", "Wintersonnenwende"},
+}
+
+// firsts berechnet die ersten Feste, Jahreszeiten und Zeitumstellungen ab der Geburt. Ein
+// Ereignis am Tag der Geburt zählt bereits als erstes.
+func firsts(birth time.Time) []first {
+	loc := birth.Location()
+
+	christmas := firstOccurrence(birth, func(year int) time.Time {
+		return time.Date(year, time.December, 24, 0, 0, 0, 0, loc)
+	})
+	results := []first{
+		{
+			id:      "christmas",
+			label:   "Erstes Weihnachten",
+			emoji:   "🎄",
+			date:    christmas,
+			endDate: christmas.AddDate(0, 0, 2),
+			note:    "Von Heiligabend bis zum zweiten Weihnachtsfeiertag.",
+		},
+		{
+			id:    "new-years-eve",
+			label: "Erstes Silvester",
+			emoji: "🎆",
+			date: firstOccurrence(birth, func(year int) time.Time {
+				return time.Date(year, time.December, 31, 0, 0, 0, 0, loc)
+			}),
+		},
+		{
+			id:    "easter",
+			label: "Erstes Ostern",
+			emoji: "🐣",
+			date: firstOccurrence(birth, func(year int) time.Time {
+				return almanac.Easter(year, loc)
+			}),
+			note: "Ostersonntag, der erste Sonntag nach dem ersten Vollmond im Frühling.",
+		},
+	}
+
+	for _, season := range firstSeasons {
+		start := firstOccurrence(birth, func(year int) time.Time {
+			return almanac.SeasonStart(year, season.season, loc)
+		})
+		results = append(results, first{
+			id:    season.id,
+			label: season.label,
+			emoji: season.emoji,
+			date:  time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, loc),
+			note:  fmt.Sprintf("%s um %s Uhr (%s), auf der Nordhalbkugel.", season.event, start.Format("15:04"), loc),
+		})
+	}
+
+	// Die erste Umstellung in jede Richtung innerhalb von zwei Jahren, ohne Sommerzeit in der
+	// Zeitzone entfällt sie
+	var forward, backward bool
+	for _, transition := range almanac.Transitions(birth, birth.AddDate(2, 0, 0)) {
+		if (transition.Forward() && forward) || (!transition.Forward() && backward) {
+			continue
+		}
+		before := transition.At.In(time.FixedZone("", transition.OldOffset)).Format("15:04")
+		after := transition.At.In(loc)
+		entry := first{
+			emoji: "⏰",
+			date:  time.Date(after.Year(), after.Month(), after.Day(), 0, 0, 0, 0, loc),
+		}
+		if transition.Forward() {
+			forward = true
+			entry.id = "dst-forward"
+			entry.label = "Erste Zeitumstellung auf Sommerzeit"
+			entry.note = fmt.Sprintf("Um %s Uhr werden die Uhren auf %s Uhr vorgestellt.", before, after.Format("15:04"))
+		} else {
+			backward = true
+			entry.id = "dst-backward"
+			entry.label = "Erste Zeitumstellung auf Winterzeit"
+			entry.note = fmt.Sprintf("Um %s Uhr werden die Uhren auf %s Uhr zurückgestellt.", before, after.Format("15:04"))
+		}
+		results = append(results, entry)
+	}
+	return results
+}
+
+// calculateFirstsResults berechnet die ersten Feste, Jahreszeiten und Zeitumstellungen
+func calculateFirstsResults(birth time.Time, excludedCategories []string) []models.ResultEntry {
+	if slices.Contains(excludedCategories, categoryFirsts) {
+		return nil
+	}
+
+	var results []models.ResultEntry
+	for _, first := range firsts(birth) {
+		var notes []string
+		if first.note != "" {
+			notes = append(notes, first.note)
+		}
+		results = append(results, models.ResultEntry{
+			OriginalValues: models.TimePeriod{
+				Categories: []string{categoryFirsts},
+				Emoji:      first.emoji,
+			},
+			ResultDate:          first.date,
+			FormattedDate:       first.date.Format("02.01.2006"),
+			ResultId:            "first-" + first.id,
+			FormattedTimePeriod: first.label,
+			DaysBetween:         daysBetween(birth, first.date),
+			Emoji:               first.emoji,
+			Categories:          []string{categoryFirsts},
+			Notes:               notes,
+			EndDate:             first.endDate,
+		})
+	}
+	return results
+}
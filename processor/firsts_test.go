@@ -0,0 +1,63 @@
+package processor
+
+import (
+	"baby-calendar/models"
+	"testing"
+	"time"
+)
+
+func TestCalculateFirstsResults(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skip("time zone data not available")
+	}
+	// Geboren am Ostersonntag 2025
+	birth := time.Date(2025, time.April, 20, 0, 0, 0, 0, berlin)
+
+	byID := map[string]models.ResultEntry{}
+	for _, result := range calculateFirstsResults(birth, nil) {
+		byID[result.ResultId] = result
+	}
+	day := func(month time.Month, d, year int) time.Time {
+		return time.Date(year, month, d, 0, 0, 0, 0, berlin)
+	}
+	tests := []struct {
+		id   string
+		want time.Time
+	}{
+		// Ein Ereignis am Tag der Geburt zählt bereits als erstes
+		{"first-easter", day(time.April, 20, 2025)},
+		{"first-christmas", day(time.December, 24, 2025)},
+		{"first-new-years-eve", day(time.December, 31, 2025)},
+		{"first-summer", day(time.June, 21, 2025)},
+		{"first-spring", day(time.March, 20, 2026)},
+		{"first-dst-backward", day(time.October, 26, 2025)},
+		{"first-dst-forward", day(time.March, 29, 2026)},
+	}
+	for _, tt := range tests {
+		result, ok := byID[tt.id]
+		if !ok {
+			t.Errorf("missing %s", tt.id)
+			continue
+		}
+		if !result.ResultDate.Equal(tt.want) {
+			t.Errorf("%s = %s, want %s", tt.id, result.ResultDate.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+		}
+	}
+	if end := byID["first-christmas"].EndDate; !end.Equal(day(time.December, 26, 2025)) {
+		t.Errorf("first-christmas ends %s, want 2025-12-26", end.Format("2006-01-02"))
+	}
+	if notes := byID["first-dst-forward"].Notes; len(notes) != 1 || notes[0] != "Um 02:00 Uhr werden die Uhren auf 03:00 Uhr vorgestellt." {
+		t.Errorf("first-dst-forward notes = %q", notes)
+	}
+
+	// Ohne Sommerzeit gibt es keine Zeitumstellung
+	for _, result := range calculateFirstsResults(time.Date(2025, time.April, 20, 0, 0, 0, 0, time.UTC), nil) {
+		if result.ResultId == "first-dst-forward" || result.ResultId == "first-dst-backward" {
+			t.Errorf("%s in UTC", result.ResultId)
+		}
+	}
+	if results := calculateFirstsResults(birth, []string{categoryFirsts}); results != nil {
+		t.Errorf("excluded firsts category returned %d results", len(results))
+	}
+}
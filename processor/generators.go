@@ -0,0 +1,169 @@
+package processor
+
+import (
+	"baby-calendar/models"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Höchstzahl der Einträge, die ein Generator erzeugen darf
+const maxGeneratedPeriods = 10000
+
+// Größte Zahl, bis zu der nach Palindromen gesucht wird
+const maxPalindrome = 1000000
+
+// generatorSpec beschreibt eine Familie von Zeitperioden in der JSON-Datei, z.B.
+// {"generate": "sequence", "unit": "days", "from": 1000, "to": 36000, "step": 1000}
+type generatorSpec struct {
+	Generate   string   `json:"generate"` // sequence, repdigits, powers, fibonacci oder palindromes
+	Unit       string   `json:"unit"`     // years, months, weeks oder days
+	From       int      `json:"from"`     // Kleinste Zahl (einschließlich)
+	To         int      `json:"to"`       // Größte Zahl (einschließlich)
+	Step       int      `json:"step"`     // Abstand bei sequence, Standard 1
+	Base       int      `json:"base"`     // Basis bei powers, Standard 2
+	Except     []int    `json:"except"`   // Zahlen, die ausgelassen werden
+	Categories []string `json:"categories"`
+	Emoji      string   `json:"emoji"`
+}
+
+// Position der Einheit in den Werten [Jahr, Monat, Woche, Tag]
+var generatorUnits = map[string]int{"years": 0, "months": 1, "weeks": 2, "days": 3}
+
+// expandGenerator erzeugt die Zeitperioden eines Generators in aufsteigender Reihenfolge
+func expandGenerator(spec generatorSpec) ([]models.TimePeriod, error) {
+	unit, ok := generatorUnits[spec.Unit]
+	if !ok {
+		return nil, fmt.Errorf("Unbekannte Einheit %q, erlaubt sind years, months, weeks und days", spec.Unit)
+	}
+	if spec.From > spec.To {
+		return nil, fmt.Errorf("from (%d) ist größer als to (%d)", spec.From, spec.To)
+	}
+
+	numbers, err := generateNumbers(spec)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := spec.Categories
+	if categories == nil {
+		categories = []string{}
+	}
+	emoji := spec.Emoji
+	if emoji == "" {
+		emoji = "✨"
+	}
+
+	periods := make([]models.TimePeriod, 0, len(numbers))
+	for _, number := range numbers {
+		if slices.Contains(spec.Except, number) {
+			continue
+		}
+		period := models.TimePeriod{Categories: slices.Clone(categories), Emoji: emoji}
+		period.Values[unit] = number
+		periods = append(periods, period)
+	}
+	return periods, nil
+}
+
+// generateNumbers liefert die Zahlen eines Generators zwischen From und To
+func generateNumbers(spec generatorSpec) ([]int, error) {
+	var numbers []int
+	add := func(number int) error {
+		if number >= spec.From && number <= spec.To {
+			if len(numbers) >= maxGeneratedPeriods {
+				return fmt.Errorf("Generator %s erzeugt mehr als %d Einträge", spec.Generate, maxGeneratedPeriods)
+			}
+			numbers = append(numbers, number)
+		}
+		return nil
+	}
+
+	switch spec.Generate {
+	case "sequence":
+		step := spec.Step
+		if step == 0 {
+			step = 1
+		}
+		if step < 0 {
+			return nil, fmt.Errorf("step muss positiv sein")
+		}
+		for number := spec.From; number <= spec.To; number += step {
+			if err := add(number); err != nil {
+				return nil, err
+			}
+			// Der nächste Schritt läge hinter To und könnte überlaufen
+			if number > spec.To-step {
+				break
+			}
+		}
+	case "repdigits":
+		// Zahlen aus mindestens zwei gleichen Ziffern: 11, 22, …, 111, 222, …
+		for digits := 2; digits <= len(strconv.Itoa(max(spec.To, 0))); digits++ {
+			for digit := 1; digit <= 9; digit++ {
+				number, err := strconv.Atoi(strings.Repeat(strconv.Itoa(digit), digits))
+				if err != nil {
+					// Die Zahl ist größer als der größte int und damit auch als To
+					break
+				}
+				if err := add(number); err != nil {
+					return nil, err
+				}
+			}
+		}
+	case "powers":
+		base := spec.Base
+		if base == 0 {
+			base = 2
+		}
+		if base < 2 {
+			return nil, fmt.Errorf("base muss mindestens 2 sein")
+		}
+		for number := 1; number <= spec.To; number *= base {
+			if err := add(number); err != nil {
+				return nil, err
+			}
+			// Vor dem Multiplizieren prüfen, damit die Zahl nicht überläuft
+			if number > spec.To/base {
+				break
+			}
+		}
+	case "fibonacci":
+		for a, b := 1, 2; a <= spec.To; a, b = b, a+b {
+			if err := add(a); err != nil {
+				return nil, err
+			}
+			// b liegt hinter To, die Summe a+b wird nicht mehr gebraucht
+			if b > spec.To {
+				break
+			}
+			// Die Summe a+b liefe über, b ist dann die letzte Zahl
+			if a > math.MaxInt-b {
+				if err := add(b); err != nil {
+					return nil, err
+				}
+				break
+			}
+		}
+	case "palindromes":
+		// Zahlen mit mindestens zwei Ziffern, die vorwärts und rückwärts gleich gelesen werden
+		if spec.To > maxPalindrome {
+			return nil, fmt.Errorf("to darf bei palindromes höchstens %d sein", maxPalindrome)
+		}
+		for number := max(spec.From, 10); number <= spec.To; number++ {
+			text := []byte(strconv.Itoa(number))
+			reversed := slices.Clone(text)
+			slices.Reverse(reversed)
+			if slices.Equal(text, reversed) {
+				if err := add(number); err != nil {
+					return nil, err
+				}
+			}
+		}
+	default:
+		return nil, fmt.Errorf("Unbekannter Generator %q, erlaubt sind sequence, repdigits, powers, fibonacci und palindromes", spec.Generate)
+	}
+	return numbers, nil
+}
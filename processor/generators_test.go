@@ -0,0 +1,143 @@
+package processor
+
+import (
+	"baby-calendar/models"
+	"encoding/json"
+	"math"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// periodKeys wandelt Zeitperioden in sortierte JSON-Schlüssel um, damit Listen unabhängig von
+// der Reihenfolge verglichen werden können
+func periodKeys(t *testing.T, periods []models.TimePeriod) []string {
+	t.Helper()
+	keys := make([]string, 0, len(periods))
+	for _, period := range periods {
+		key, err := json.Marshal(period)
+		if err != nil {
+			t.Fatal(err)
+		}
+		keys = append(keys, string(key))
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+// Die Generatoren in data/periods.json müssen genau die Einträge der ausgeschriebenen Liste
+// von vor ihrer Einführung ergeben. Die Fibonacci-Tage kamen mit den Generatoren neu hinzu.
+func TestPeriodsMatchBaseline(t *testing.T) {
+	periods, err := LoadTimePeriods("../data/periods.json")
+	if err != nil {
+		t.Fatalf("LoadTimePeriods: %v", err)
+	}
+	var generated []models.TimePeriod
+	var fibonacci []int
+	for _, period := range periods {
+		if slices.Contains(period.Categories, "fibonacci-days") {
+			fibonacci = append(fibonacci, period.Values[3])
+			continue
+		}
+		generated = append(generated, period)
+	}
+	if want := []int{13, 21, 34, 55, 89, 144, 233, 377, 610, 987, 1597, 2584, 4181, 6765, 10946, 17711, 28657, 46368}; !slices.Equal(fibonacci, want) {
+		t.Errorf("fibonacci days = %v, want %v", fibonacci, want)
+	}
+	baseline, err := LoadTimePeriods("testdata/periods-baseline.json")
+	if err != nil {
+		t.Fatalf("LoadTimePeriods baseline: %v", err)
+	}
+
+	got, want := periodKeys(t, generated), periodKeys(t, baseline)
+	for _, key := range got {
+		if _, found := slices.BinarySearch(want, key); !found {
+			t.Errorf("unexpected period %s", key)
+		}
+	}
+	for _, key := range want {
+		if _, found := slices.BinarySearch(got, key); !found {
+			t.Errorf("missing period %s", key)
+		}
+	}
+	if len(got) != len(want) {
+		t.Errorf("got %d periods, want %d", len(got), len(want))
+	}
+}
+
+func TestGenerateNumbers(t *testing.T) {
+	tests := []struct {
+		name string
+		spec generatorSpec
+		want []int
+	}{
+		{"sequence", generatorSpec{Generate: "sequence", From: 1, To: 5}, []int{1, 2, 3, 4, 5}},
+		{"sequence step", generatorSpec{Generate: "sequence", From: 100, To: 450, Step: 100}, []int{100, 200, 300, 400}},
+		{"sequence near MaxInt", generatorSpec{Generate: "sequence", From: math.MaxInt - 2, To: math.MaxInt, Step: 2}, []int{math.MaxInt - 2, math.MaxInt}},
+		{"repdigits", generatorSpec{Generate: "repdigits", From: 10, To: 44}, []int{11, 22, 33, 44}},
+		{"repdigits bounds", generatorSpec{Generate: "repdigits", From: 111, To: 1111}, []int{111, 222, 333, 444, 555, 666, 777, 888, 999, 1111}},
+		{"repdigits single digits", generatorSpec{Generate: "repdigits", From: 1, To: 9}, nil},
+		{"repdigits near MaxInt", generatorSpec{Generate: "repdigits", From: 8888888888888888888, To: math.MaxInt}, []int{8888888888888888888}},
+		{"powers", generatorSpec{Generate: "powers", From: 2, To: 64}, []int{2, 4, 8, 16, 32, 64}},
+		{"powers base 10", generatorSpec{Generate: "powers", Base: 10, From: 1, To: 5000}, []int{1, 10, 100, 1000}},
+		{"powers near MaxInt", generatorSpec{Generate: "powers", From: 1 << 61, To: math.MaxInt}, []int{1 << 61, 1 << 62}},
+		{"powers base 3 near MaxInt", generatorSpec{Generate: "powers", Base: 3, From: 1 << 61, To: math.MaxInt}, []int{4052555153018976267}},
+		{"fibonacci", generatorSpec{Generate: "fibonacci", From: 1, To: 60}, []int{1, 2, 3, 5, 8, 13, 21, 34, 55}},
+		{"fibonacci near MaxInt", generatorSpec{Generate: "fibonacci", From: 5000000000000000000, To: math.MaxInt}, []int{7540113804746346429}},
+		{"palindromes", generatorSpec{Generate: "palindromes", From: 1, To: 121}, []int{11, 22, 33, 44, 55, 66, 77, 88, 99, 101, 111, 121}},
+	}
+	for _, tt := range tests {
+		got, err := generateNumbers(tt.spec)
+		if err != nil {
+			t.Errorf("%s: %v", tt.name, err)
+			continue
+		}
+		if !slices.Equal(got, tt.want) {
+			t.Errorf("%s = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestGenerateNumbersErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		spec generatorSpec
+		want string
+	}{
+		{"negative step", generatorSpec{Generate: "sequence", From: 1, To: 5, Step: -1}, "step"},
+		{"base 1", generatorSpec{Generate: "powers", Base: 1, From: 1, To: 5}, "base"},
+		{"negative base", generatorSpec{Generate: "powers", Base: -2, From: 1, To: 5}, "base"},
+		{"too many", generatorSpec{Generate: "sequence", From: 1, To: maxGeneratedPeriods + 1}, "mehr als"},
+		{"palindromes limit", generatorSpec{Generate: "palindromes", From: 1, To: maxPalindrome + 1}, "höchstens"},
+		{"unknown", generatorSpec{Generate: "primes", From: 1, To: 5}, "Unbekannter Generator"},
+	}
+	for _, tt := range tests {
+		if _, err := generateNumbers(tt.spec); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("%s: error %v, want one containing %q", tt.name, err, tt.want)
+		}
+	}
+}
+
+func TestExpandGenerator(t *testing.T) {
+	periods, err := expandGenerator(generatorSpec{Generate: "sequence", Unit: "weeks", From: 48, To: 52, Except: []int{50}, Categories: []string{"first-year-weeks"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var weeks []int
+	for _, period := range periods {
+		weeks = append(weeks, period.Values[2])
+		if period.Emoji != "✨" || !slices.Equal(period.Categories, []string{"first-year-weeks"}) {
+			t.Errorf("week %d = %q %v", period.Values[2], period.Emoji, period.Categories)
+		}
+	}
+	if want := []int{48, 49, 51, 52}; !slices.Equal(weeks, want) {
+		t.Errorf("weeks = %v, want %v", weeks, want)
+	}
+
+	if _, err := expandGenerator(generatorSpec{Generate: "sequence", Unit: "hours", From: 1, To: 2}); err == nil {
+		t.Error("unknown unit accepted")
+	}
+	if _, err := expandGenerator(generatorSpec{Generate: "sequence", Unit: "days", From: 3, To: 2}); err == nil {
+		t.Error("from > to accepted")
+	}
+}
@@ -0,0 +1,94 @@
+package processor
+
+import (
+	"baby-calendar/models"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Kategorie der rechtlichen Altersgrenzen
+const categoryLegal = "legal"
+
+// LoadLegalRules lädt die rechtlichen Altersgrenzen je Land aus der JSON-Datei. Die Einträge
+// haben dasselbe Listenformat wie die Zeitperioden.
+func LoadLegalRules(filePath string) (models.LegalRules, error) {
+	byteValue, err := os.ReadFile(filePath)
+	if err != nil {
+		return models.LegalRules{}, fmt.Errorf("Fehler beim Lesen der Datei: %w", err)
+	}
+
+	var rawData struct {
+		Version   string `json:"version"`
+		Countries map[string]struct {
+			Name       string            `json:"name"`
+			Milestones []json.RawMessage `json:"milestones"`
+		} `json:"countries"`
+	}
+	if err := json.Unmarshal(byteValue, &rawData); err != nil {
+		return models.LegalRules{}, fmt.Errorf("Fehler beim Unmarshalling des JSON: %w", err)
+	}
+
+	rules := models.LegalRules{Version: rawData.Version, Countries: map[string]models.LegalCountry{}}
+	for code, country := range rawData.Countries {
+		// Die ID eines Eintrags ergibt sich aus Thema und Alter
+		milestones, err := parseTimePeriods(country.Milestones, func(milestone models.TimePeriod) string {
+			return legalResultID(code, milestone)
+		})
+		if err != nil {
+			return models.LegalRules{}, fmt.Errorf("Fehler bei %s: %w", code, err)
+		}
+		for index, milestone := range milestones {
+			if !slices.Contains(milestone.Categories, categoryLegal) {
+				milestones[index].Categories = append(milestone.Categories, categoryLegal)
+			}
+		}
+		rules.Countries[code] = models.LegalCountry{Name: country.Name, Milestones: milestones}
+	}
+	return rules, nil
+}
+
+// legalResultID liefert eine stabile ID aus Land, Thema (erste Kategorie außer "legal") und Alter
+func legalResultID(code string, milestone models.TimePeriod) string {
+	topic := categoryLegal
+	for _, category := range milestone.Categories {
+		if category != categoryLegal {
+			topic = strings.TrimPrefix(category, categoryLegal+"-")
+			break
+		}
+	}
+	values := milestone.Values
+	return fmt.Sprintf("%s-%s-%d-%d-%d-%d", strings.ToLower(code), topic, values[0], values[1], values[2], values[3])
+}
+
+// ValidateCountry prüft, ob es für den Ländercode Altersgrenzen gibt
+func ValidateCountry(rules models.LegalRules, country string) error {
+	if _, ok := rules.Countries[country]; ok {
+		return nil
+	}
+	codes := make([]string, 0, len(rules.Countries))
+	for code := range rules.Countries {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return fmt.Errorf("Invalid country value %q. Use one of %s.", country, strings.Join(codes, ", "))
+}
+
+// calculateLegalResults berechnet die rechtlichen Altersgrenzen des Landes in den Optionen.
+// Sie gelten immer ab der tatsächlichen Geburt, auch wenn nur das korrigierte Alter angezeigt wird.
+func calculateLegalResults(rules models.LegalRules, birth time.Time, excludedCategories []string, opts Options) []models.ResultEntry {
+	country, ok := rules.Countries[opts.Country]
+	if !ok || slices.Contains(excludedCategories, categoryLegal) {
+		return nil
+	}
+
+	results := calculatePeriodResults(country.Milestones, birth, birth, excludedCategories, opts)
+	for i := range results {
+		results[i].ResultId = "legal-" + legalResultID(opts.Country, results[i].OriginalValues)
+		results[i].Notes = append(results[i].Notes, fmt.Sprintf("Gilt in %s, Stand %s.", country.Name, rules.Version))
+	}
+	return results
+}
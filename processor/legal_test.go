@@ -0,0 +1,91 @@
+package processor
+
+import (
+	"baby-calendar/datecalc"
+	"baby-calendar/models"
+	"encoding/json"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCalculateLegalResults(t *testing.T) {
+	rules, err := LoadLegalRules("../data/legal.json")
+	if err != nil {
+		t.Fatalf("LoadLegalRules: %v", err)
+	}
+
+	results := calculateLegalResults(rules, date(2010, time.June, 15), nil, Options{Country: "DE"})
+	if len(results) != len(rules.Countries["DE"].Milestones) {
+		t.Errorf("got %d results, want %d", len(results), len(rules.Countries["DE"].Milestones))
+	}
+	byID := map[string]int{}
+	for index, result := range results {
+		if _, ok := byID[result.ResultId]; ok {
+			t.Errorf("duplicate result ID %q", result.ResultId)
+		}
+		byID[result.ResultId] = index
+		if !slices.Contains(result.Categories, categoryLegal) {
+			t.Errorf("%s has categories %v", result.ResultId, result.Categories)
+		}
+	}
+	index, ok := byID["legal-de-rights-18-0-0-0"]
+	if !ok {
+		t.Fatal("missing legal-de-rights-18-0-0-0")
+	}
+	adult := results[index]
+	if !adult.ResultDate.Equal(date(2028, time.June, 15)) {
+		t.Errorf("legal-de-rights-18-0-0-0 on %s, want 2028-06-15", adult.ResultDate.Format("2006-01-02"))
+	}
+	if note := adult.Notes[len(adult.Notes)-1]; note != "Gilt in Deutschland, Stand "+rules.Version+"." {
+		t.Errorf("note = %q", note)
+	}
+
+	// Am 29. Februar Geborene folgen der Monatsende-Regel
+	for policy, want := range map[datecalc.MonthEndPolicy]time.Time{
+		datecalc.MonthEndNormalize: date(2026, time.March, 1),
+		datecalc.MonthEndClamp:     date(2026, time.February, 28),
+	} {
+		opts := Options{Country: "DE", Policy: datecalc.Policy{MonthEnd: policy}}
+		for _, result := range calculateLegalResults(rules, date(2008, time.February, 29), nil, opts) {
+			if result.ResultId == "legal-de-rights-18-0-0-0" && !result.ResultDate.Equal(want) {
+				t.Errorf("%s: 18th birthday on %s, want %s", policy, result.ResultDate.Format("2006-01-02"), want.Format("2006-01-02"))
+			}
+		}
+	}
+
+	if results := calculateLegalResults(rules, date(2010, time.June, 15), nil, Options{}); results != nil {
+		t.Errorf("without country got %d results", len(results))
+	}
+	if results := calculateLegalResults(rules, date(2010, time.June, 15), []string{categoryLegal}, Options{Country: "DE"}); results != nil {
+		t.Errorf("excluded legal category returned %d results", len(results))
+	}
+}
+
+func TestValidateCountry(t *testing.T) {
+	rules, err := LoadLegalRules("../data/legal.json")
+	if err != nil {
+		t.Fatalf("LoadLegalRules: %v", err)
+	}
+	for code := range rules.Countries {
+		if err := ValidateCountry(rules, code); err != nil {
+			t.Errorf("ValidateCountry(%q): %v", code, err)
+		}
+	}
+	if err := ValidateCountry(rules, "FR"); err == nil || !strings.Contains(err.Error(), "AT, CH, DE") {
+		t.Errorf("ValidateCountry(FR) error = %v", err)
+	}
+}
+
+func TestLegalResultIDsUnique(t *testing.T) {
+	// Gleiches Thema und Alter ergeben dieselbe ID und werden abgelehnt
+	raw := []json.RawMessage{
+		json.RawMessage(`[18, 0, 0, 0, ["legal", "legal-rights"], "🎓", "Volljährigkeit"]`),
+		json.RawMessage(`[18, 0, 0, 0, ["legal", "legal-rights"], "⚖️", "Volle Geschäftsfähigkeit"]`),
+	}
+	_, err := parseTimePeriods(raw, func(milestone models.TimePeriod) string { return legalResultID("DE", milestone) })
+	if err == nil || !strings.Contains(err.Error(), "de-rights-18-0-0-0") {
+		t.Errorf("parseTimePeriods error = %v, want duplicate ID", err)
+	}
+}
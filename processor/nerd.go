@@ -0,0 +1,147 @@
+package processor
+
+import (
+	"baby-calendar/display"
+	"baby-calendar/models"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kategorie der Meilensteine für Nerds: Zweierpotenzen, runde Hexadezimalzahlen, π und e
+const categoryNerd = "nerd"
+
+// Sekunden je Einheit der Meilensteine
+var nerdUnitSeconds = map[string]int64{"days": 24 * 60 * 60, "hours": 60 * 60, "seconds": 1}
+
+// Stunden und Sekunden in Einzahl und Mehrzahl, Tage formatiert FormatTimePeriod
+var nerdUnitWords = map[string][2]string{
+	"hours":   {"Stunde", "Stunden"},
+	"seconds": {"Sekunde", "Sekunden"},
+}
+
+// nerdMilestone ist ein berechneter Meilenstein aus value Einheiten. Der Titel wird in der
+// Schreibweise notation erzeugt, außer label ist gesetzt.
+type nerdMilestone struct {
+	id       string
+	label    string
+	notation Notation
+	unit     string
+	value    int
+	note     string
+}
+
+// formatNerdValue formatiert value Einheiten in der Schreibweise notation, Tage mit
+// FormatTimePeriod, Stunden und Sekunden mit denselben Regeln
+func formatNerdValue(value int, unit string, notation Notation) string {
+	if unit == "days" {
+		return FormatTimePeriod(0, 0, 0, value, notation)
+	}
+	words := nerdUnitWords[unit]
+	return formatCount(value, words[0], words[1], notation)
+}
+
+// formatLabel liefert den Titel des Meilensteins
+func (m nerdMilestone) formatLabel() string {
+	if m.label != "" {
+		return m.label
+	}
+	return formatNerdValue(m.value, m.unit, m.notation)
+}
+
+// powerOfTwoMilestones liefert die Zweierpotenzen 2^from bis 2^to in der Einheit unit
+func powerOfTwoMilestones(unit string, from, to int) []nerdMilestone {
+	var milestones []nerdMilestone
+	for exponent := from; exponent <= to; exponent++ {
+		value := 1 << exponent
+		milestones = append(milestones, nerdMilestone{
+			id:       fmt.Sprintf("%s-2-%d", unit, exponent),
+			notation: NotationPowerOfTwo,
+			unit:     unit,
+			value:    value,
+			note:     fmt.Sprintf("%s = %s, binär %s, hexadezimal %s.", formatNerdValue(value, unit, NotationPowerOfTwo), formatNerdValue(value, unit, NotationDecimal), strconv.FormatInt(int64(value), 2), formatNerdValue(value, unit, NotationHex)),
+		})
+	}
+	return milestones
+}
+
+// nerdMilestones liefert alle Meilensteine der Kategorie, die in den ersten 100 Jahren liegen
+func nerdMilestones() []nerdMilestone {
+	var milestones []nerdMilestone
+	milestones = append(milestones, powerOfTwoMilestones("days", 5, 15)...)     // 32 Tage bis etwa 90 Jahre
+	milestones = append(milestones, powerOfTwoMilestones("hours", 10, 19)...)   // etwa 43 Tage bis 60 Jahre
+	milestones = append(milestones, powerOfTwoMilestones("seconds", 22, 31)...) // etwa 49 Tage bis 68 Jahre
+
+	for value := 0x1000; value <= 0x8000; value += 0x1000 {
+		note := fmt.Sprintf("%s = %s.", formatNerdValue(value, "days", NotationHex), formatNerdValue(value, "days", NotationDecimal))
+		// 0x1000, 0x2000, 0x4000 und 0x8000 fallen auf denselben Tag wie eine Zweierpotenz
+		if value&(value-1) == 0 {
+			note = fmt.Sprintf("%s = %s = %s.", formatNerdValue(value, "days", NotationHex), formatNerdValue(value, "days", NotationPowerOfTwo), formatNerdValue(value, "days", NotationDecimal))
+		}
+		milestones = append(milestones, nerdMilestone{
+			id:       fmt.Sprintf("days-0x%x", value),
+			notation: NotationHex,
+			unit:     "days",
+			value:    value,
+			note:     note,
+		})
+	}
+
+	pi := int(math.Round(math.Pi * 1000))
+	e := int(math.Round(math.E * 10000))
+	milestones = append(milestones,
+		nerdMilestone{
+			id:    "days-pi",
+			label: "π × 1000 Tage",
+			unit:  "days",
+			value: pi,
+			note:  fmt.Sprintf("π × 1000 = %s, gerundet %s.", strings.Replace(fmt.Sprintf("%.3f", math.Pi*1000), ".", ",", 1), formatNerdValue(pi, "days", NotationDecimal)),
+		},
+		nerdMilestone{
+			id:    "days-e",
+			label: "e × 10000 Tage",
+			unit:  "days",
+			value: e,
+			note:  fmt.Sprintf("e × 10000 = %s, gerundet %s.", strings.Replace(fmt.Sprintf("%.3f", math.E*10000), ".", ",", 1), formatNerdValue(e, "days", NotationDecimal)),
+		},
+	)
+	return milestones
+}
+
+// calculateNerdResults berechnet die Meilensteine der Kategorie nerd. Stunden und Sekunden
+// werden ab Mitternacht am Tag des Ankerereignisses gezählt.
+func calculateNerdResults(birth time.Time, excludedCategories []string, opts Options) []models.ResultEntry {
+	if slices.Contains(excludedCategories, categoryNerd) {
+		return nil
+	}
+
+	var results []models.ResultEntry
+	for _, milestone := range nerdMilestones() {
+		seconds := int64(milestone.value) * nerdUnitSeconds[milestone.unit]
+		date := birth.AddDate(0, 0, int(seconds/nerdUnitSeconds["days"]))
+
+		notes := []string{milestone.note}
+		if remainder := seconds % nerdUnitSeconds["days"]; remainder != 0 {
+			notes = append(notes, display.GetCountedFromMidnight(opts.Anchor, remainder/3600, remainder/60%60, remainder%60))
+		}
+
+		results = append(results, models.ResultEntry{
+			OriginalValues: models.TimePeriod{
+				Categories: []string{categoryNerd},
+				Emoji:      "🤓",
+			},
+			ResultDate:          date,
+			FormattedDate:       date.Format("02.01.2006"),
+			ResultId:            "nerd-" + milestone.id,
+			FormattedTimePeriod: milestone.formatLabel(),
+			DaysBetween:         daysBetween(birth, date),
+			Emoji:               "🤓",
+			Categories:          []string{categoryNerd},
+			Notes:               notes,
+		})
+	}
+	return results
+}
@@ -0,0 +1,76 @@
+package processor
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFormatTimePeriod(t *testing.T) {
+	tests := []struct {
+		years, months, weeks, days int
+		notation                   []Notation
+		want                       string
+	}{
+		{0, 0, 0, 0, nil, "Geburtstag"},
+		{1, 0, 0, 0, nil, "1 Jahr"},
+		{0, 2, 0, 1, nil, "2 Monate und 1 Tag"},
+		{1, 2, 3, 4, nil, "1 Jahr, 2 Monate, 3 Wochen und 4 Tage"},
+		{0, 0, -3, 0, nil, "noch 3 Wochen"},
+		{0, 0, 0, 8192, []Notation{NotationPowerOfTwo}, "2¹³ Tage"},
+		{0, 0, 0, 16384, []Notation{NotationPowerOfTwo}, "2¹⁴ Tage"},
+		{0, 0, 0, 8192, []Notation{NotationHex}, "0x2000 Tage"},
+		{0, 0, 0, 0x3000, []Notation{NotationHex}, "0x3000 Tage"},
+		{0, 0, 0, 8192, []Notation{NotationDecimal}, "8192 Tage"},
+		{0, 0, 0, -32, []Notation{NotationPowerOfTwo}, "noch 2⁵ Tage"},
+	}
+	for _, tt := range tests {
+		if got := FormatTimePeriod(tt.years, tt.months, tt.weeks, tt.days, tt.notation...); got != tt.want {
+			t.Errorf("FormatTimePeriod(%d, %d, %d, %d, %v) = %q, want %q", tt.years, tt.months, tt.weeks, tt.days, tt.notation, got, tt.want)
+		}
+	}
+}
+
+func TestCalculateNerdResults(t *testing.T) {
+	birth := date(2023, time.January, 1)
+	results := calculateNerdResults(birth, nil, Options{})
+
+	byID := map[string]string{}
+	dates := map[string]time.Time{}
+	for _, result := range results {
+		if _, ok := byID[result.ResultId]; ok {
+			t.Errorf("duplicate result ID %q", result.ResultId)
+		}
+		byID[result.ResultId] = result.FormattedTimePeriod
+		dates[result.ResultId] = result.ResultDate
+	}
+
+	tests := []struct {
+		id    string
+		label string
+		date  time.Time
+	}{
+		{"nerd-days-2-5", "2⁵ Tage", date(2023, time.February, 2)},
+		{"nerd-days-2-13", "2¹³ Tage", date(2045, time.June, 6)},
+		// Hexadezimale Zweierpotenzen stehen zusätzlich in ihrer eigenen Schreibweise
+		{"nerd-days-0x2000", "0x2000 Tage", date(2045, time.June, 6)},
+		{"nerd-days-0x3000", "0x3000 Tage", date(2056, time.August, 23)},
+		{"nerd-hours-2-10", "2¹⁰ Stunden", date(2023, time.February, 12)},
+		{"nerd-seconds-2-30", "2³⁰ Sekunden", date(2057, time.January, 9)},
+		{"nerd-days-pi", "π × 1000 Tage", date(2031, time.August, 9)},
+		{"nerd-days-e", "e × 10000 Tage", date(2097, time.June, 4)},
+	}
+	for _, tt := range tests {
+		label, ok := byID[tt.id]
+		if !ok {
+			t.Errorf("missing %s", tt.id)
+			continue
+		}
+		if label != tt.label || !dates[tt.id].Equal(tt.date) {
+			t.Errorf("%s = %q on %s, want %q on %s", tt.id, label, dates[tt.id].Format("2006-01-02"), tt.label, tt.date.Format("2006-01-02"))
+		}
+	}
+
+	if results := calculateNerdResults(birth, []string{categoryNerd}, Options{}); results != nil {
+		t.Errorf("excluded nerd category returned %d results", len(results))
+	}
+}
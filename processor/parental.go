@@ -0,0 +1,162 @@
+package processor
+
+import (
+	"baby-calendar/datecalc"
+	"baby-calendar/display"
+	"baby-calendar/models"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Kategorie der Fristen zu Mutterschutz, Elterngeld und Elternzeit
+const categoryParental = "parental"
+
+// Schwangerschaftsalter in Tagen, vor dem eine Geburt als Frühgeburt gilt (37+0)
+const pretermDays = 259
+
+// parentalRule ist eine Frist mit Beschreibung und Rechtsgrundlage
+type parentalRule struct {
+	id          string
+	label       string
+	emoji       string
+	date        time.Time
+	endDate     time.Time
+	description string
+	source      string
+}
+
+// endOfLifeMonth liefert den letzten Tag des Lebensmonats month (1 = erster Lebensmonat).
+// Gesetzliche Fristen werden nach § 188 BGB gezählt: ein Lebensmonat endet am Vortag des
+// Monatstags der Geburt.
+func endOfLifeMonth(birth time.Time, month int) time.Time {
+	return datecalc.AddDeadline(birth, 0, month)
+}
+
+// parentalRules berechnet die Fristen ab der Geburt. Mit errechnetem Termin beginnt der
+// Mutterschutz sechs Wochen davor und verlängert sich nach einer vorzeitigen Geburt.
+func parentalRules(birth time.Time, opts Options) []parentalRule {
+	protectionStart := birth
+	extensionDays := 0
+	protectionWeeks := 8
+	protectionReasons := []string{"Ohne errechneten Termin beginnt der Eintrag mit der Geburt."}
+	if !opts.Due.IsZero() {
+		due := inLocationOf(opts.Due, birth)
+		protectionStart = due.AddDate(0, 0, -6*7)
+		protectionReasons = nil
+		// Bei einer Geburt mehr als sechs Wochen vor dem Termin beginnt der Eintrag mit der Geburt
+		if birth.Before(protectionStart) {
+			protectionStart = birth
+			protectionReasons = append(protectionReasons, "Die Geburt lag vor dem Beginn der Schutzfrist, der Eintrag beginnt deshalb mit der Geburt.")
+		}
+		earlyDays := max(daysBetween(birth, due), 0)
+		if pregnancyDays-earlyDays < pretermDays {
+			protectionWeeks = 12
+			protectionReasons = append(protectionReasons, "Frühgeburt: 12 statt 8 Wochen nach der Geburt.")
+		}
+		// Verlängert wird höchstens um die sechs Wochen vor dem Termin
+		extensionDays = min(earlyDays, 6*7)
+		if extensionDays > 0 {
+			protectionReasons = append(protectionReasons, fmt.Sprintf("Verlängert um %d Tage, die vor der Geburt nicht genommen werden konnten.", extensionDays))
+		}
+	}
+	protectionEnd := birth.AddDate(0, 0, protectionWeeks*7+extensionDays)
+	leaveAfterProtection := protectionEnd.AddDate(0, 0, 1)
+
+	// Elternzeit ab der Geburt wird sieben Wochen vor dem errechneten Termin angemeldet
+	leaveFromBirth := birth
+	if !opts.Due.IsZero() {
+		leaveFromBirth = inLocationOf(opts.Due, birth)
+	}
+
+	return []parentalRule{
+		{
+			id:          "mutterschutz",
+			label:       "Mutterschutz",
+			emoji:       "🤱",
+			date:        protectionStart,
+			endDate:     protectionEnd,
+			description: display.GetProtectionDescription(protectionStart, protectionEnd, protectionWeeks, protectionReasons),
+			source:      "§ 3 MuSchG",
+		},
+		{
+			id:          "elternzeit-geburt",
+			label:       "Elternzeit ab der Geburt anmelden",
+			emoji:       "📋",
+			date:        leaveFromBirth.AddDate(0, 0, -7*7),
+			description: fmt.Sprintf("Elternzeit muss spätestens sieben Wochen vor ihrem Beginn schriftlich beim Arbeitgeber angemeldet werden. Gilt für eine Elternzeit ab dem %s.", leaveFromBirth.Format("02.01.2006")),
+			source:      "§ 16 Abs. 1 BEEG",
+		},
+		{
+			id:          "elternzeit-mutterschutz",
+			label:       "Elternzeit nach dem Mutterschutz anmelden",
+			emoji:       "📋",
+			date:        leaveAfterProtection.AddDate(0, 0, -7*7),
+			description: fmt.Sprintf("Elternzeit muss spätestens sieben Wochen vor ihrem Beginn schriftlich beim Arbeitgeber angemeldet werden. Gilt für eine Elternzeit direkt im Anschluss an den Mutterschutz ab dem %s.", leaveAfterProtection.Format("02.01.2006")),
+			source:      "§ 16 Abs. 1 BEEG",
+		},
+		{
+			id:          "elterngeld-antrag",
+			label:       "Elterngeld spätestens beantragen",
+			emoji:       "💶",
+			date:        endOfLifeMonth(birth, 4),
+			description: "Elterngeld wird rückwirkend nur für die letzten drei Lebensmonate vor dem Lebensmonat gezahlt, in dem der Antrag eingeht. Heute endet der 4. Lebensmonat: Geht der Antrag bis heute ein, wird es noch ab dem ersten Lebensmonat gezahlt.",
+			source:      "§ 7 Abs. 1 BEEG",
+		},
+		{
+			id:          "basiselterngeld-12",
+			label:       "Ende des 12. Lebensmonats (Basiselterngeld)",
+			emoji:       "💶",
+			date:        endOfLifeMonth(birth, 12),
+			description: "Ein Elternteil kann höchstens 12 Monatsbeträge Basiselterngeld erhalten. Wer es ab der Geburt bezieht, erhält heute den letzten Tag.",
+			source:      "§ 4 BEEG",
+		},
+		{
+			id:          "basiselterngeld-14",
+			label:       "Ende des 14. Lebensmonats (Partnermonate)",
+			emoji:       "💶",
+			date:        endOfLifeMonth(birth, 14),
+			description: "Basiselterngeld kann nur bis zum Ende des 14. Lebensmonats bezogen werden, zusammen mit den Partnermonaten insgesamt 14 Monatsbeträge.",
+			source:      "§ 4 BEEG",
+		},
+		{
+			id:          "elternzeit-3",
+			label:       "Ende der Elternzeit bis zum 3. Geburtstag",
+			emoji:       "📋",
+			date:        datecalc.AddDeadline(birth, 3, 0),
+			description: "Elternzeit kann bis zum dritten Geburtstag genommen werden, bis zu 24 Monate davon auch später bis zum achten Geburtstag.",
+			source:      "§ 15 Abs. 2 BEEG",
+		},
+	}
+}
+
+// calculateParentalResults berechnet die Fristen zu Mutterschutz, Elterngeld und Elternzeit
+func calculateParentalResults(birth time.Time, excludedCategories []string, opts Options) []models.ResultEntry {
+	if slices.Contains(excludedCategories, categoryParental) {
+		return nil
+	}
+
+	var results []models.ResultEntry
+	for _, rule := range parentalRules(birth, opts) {
+		description := display.GetSourcedDescription(rule.description, rule.source)
+		if opts.Unborn {
+			description = display.GetSourcedDescription(rule.description+" Berechnet mit dem errechneten Termin als Geburtstag.", rule.source)
+		}
+		results = append(results, models.ResultEntry{
+			OriginalValues: models.TimePeriod{
+				Categories: []string{categoryParental},
+				Emoji:      rule.emoji,
+			},
+			ResultDate:          rule.date,
+			FormattedDate:       rule.date.Format("02.01.2006"),
+			ResultId:            "parental-" + rule.id,
+			FormattedTimePeriod: rule.label,
+			DaysBetween:         daysBetween(birth, rule.date),
+			Emoji:               rule.emoji,
+			Categories:          []string{categoryParental},
+			Description:         description,
+			EndDate:             rule.endDate,
+		})
+	}
+	return results
+}
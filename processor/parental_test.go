@@ -0,0 +1,71 @@
+package processor
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+// parentalResult liefert das Ergebnis mit der ID id aus den Fristen für Eltern
+func parentalResult(t *testing.T, birth time.Time, opts Options, id string) (time.Time, time.Time, string) {
+	t.Helper()
+	for _, result := range calculateParentalResults(birth, nil, opts) {
+		if result.ResultId == "parental-"+id {
+			return result.ResultDate, result.EndDate, result.Description
+		}
+	}
+	t.Fatalf("no parental result %q", id)
+	return time.Time{}, time.Time{}, ""
+}
+
+func TestParentalDeadlines(t *testing.T) {
+	tests := []struct {
+		birth time.Time
+		id    string
+		want  time.Time
+	}{
+		// Ein Antrag im 4. Lebensmonat deckt rückwirkend noch den 1. bis 3. Lebensmonat ab
+		{date(2025, time.January, 15), "elterngeld-antrag", date(2025, time.May, 14)},
+		{date(2025, time.January, 1), "elterngeld-antrag", date(2025, time.April, 30)},
+		{date(2024, time.October, 31), "elterngeld-antrag", date(2025, time.February, 28)},
+		{date(2025, time.January, 15), "basiselterngeld-12", date(2026, time.January, 14)},
+		{date(2025, time.January, 15), "basiselterngeld-14", date(2026, time.March, 14)},
+		{date(2025, time.January, 15), "elternzeit-3", date(2028, time.January, 14)},
+		{date(2024, time.February, 29), "elternzeit-3", date(2027, time.February, 28)},
+	}
+	for _, tt := range tests {
+		got, _, _ := parentalResult(t, tt.birth, Options{}, tt.id)
+		if !got.Equal(tt.want) {
+			t.Errorf("%s for birth %s = %s, want %s", tt.id, tt.birth.Format("2006-01-02"), got.Format("2006-01-02"), tt.want.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestParentalProtectionPeriod(t *testing.T) {
+	tests := []struct {
+		name      string
+		birth     time.Time
+		due       time.Time
+		wantStart time.Time
+		wantEnd   time.Time
+		wantWeeks string
+	}{
+		{"without due date", date(2025, time.March, 1), time.Time{}, date(2025, time.March, 1), date(2025, time.April, 26), "8 Wochen"},
+		{"on due date", date(2025, time.March, 1), date(2025, time.March, 1), date(2025, time.January, 18), date(2025, time.April, 26), "8 Wochen"},
+		// Zehn Tage zu früh: Verlängerung um die zehn Tage vor dem Termin
+		{"early", date(2025, time.February, 19), date(2025, time.March, 1), date(2025, time.January, 18), date(2025, time.April, 26), "8 Wochen"},
+		// Acht Wochen zu früh: Frühgeburt, Beginn mit der Geburt und Verlängerung um sechs Wochen
+		{"preterm before protection", date(2025, time.January, 4), date(2025, time.March, 1), date(2025, time.January, 4), date(2025, time.May, 10), "12 Wochen"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, end, description := parentalResult(t, tt.birth, Options{Due: tt.due}, "mutterschutz")
+			if !start.Equal(tt.wantStart) || !end.Equal(tt.wantEnd) {
+				t.Errorf("Mutterschutz = %s – %s, want %s – %s", start.Format("2006-01-02"), end.Format("2006-01-02"), tt.wantStart.Format("2006-01-02"), tt.wantEnd.Format("2006-01-02"))
+			}
+			if !strings.Contains(description, tt.wantWeeks) {
+				t.Errorf("description %q does not contain %q", description, tt.wantWeeks)
+			}
+		})
+	}
+}
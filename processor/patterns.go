@@ -0,0 +1,155 @@
+package processor
+
+import (
+	"baby-calendar/display"
+	"baby-calendar/models"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Kategorie der besonderen Kalenderdaten: goldener Geburtstag, Palindrom-Daten und Geburtstage
+// am selben Wochentag wie die Geburt
+const categoryDatePatterns = "date-patterns"
+
+// Besondere Kalenderdaten werden bis zu diesem Alter in Jahren gesucht
+const maxPatternAgeYears = 100
+
+var weekdayNames = []string{"Sonntag", "Montag", "Dienstag", "Mittwoch", "Donnerstag", "Freitag", "Samstag"}
+
+// datePattern ist ein Tag, der wegen seines Kalenderdatums besonders ist
+type datePattern struct {
+	id    string
+	label string
+	emoji string
+	date  time.Time
+	notes []string
+}
+
+// reverseDigits liefert die Ziffern einer zweistelligen Zahl in umgekehrter Reihenfolge
+func reverseDigits(value int) int {
+	return value%10*10 + value/10
+}
+
+// palindromeDates liefert die Tage eines Jahres, die im Format TT.MM.JJJJ oder JJJJ-MM-TT
+// ohne Trennzeichen vorwärts und rückwärts gleich gelesen werden
+func palindromeDates(year int, loc *time.Location) []datePattern {
+	century, rest := year/100, year%100
+	candidates := []struct {
+		day, month int
+		format     string
+		digits     string
+	}{
+		// TTMMJJJJ: Tag und Monat sind die umgekehrten Ziffern des Jahres
+		{reverseDigits(rest), reverseDigits(century), "02.01.2006", "02012006"},
+		// JJJJMMTT: Monat und Tag sind die umgekehrten Ziffern des Jahres
+		{reverseDigits(century), reverseDigits(rest), "2006-01-02", "20060102"},
+	}
+
+	var patterns []datePattern
+	for _, candidate := range candidates {
+		date := time.Date(year, time.Month(candidate.month), candidate.day, 0, 0, 0, 0, loc)
+		// Ungültige Tage wie der 31.02. werden von time.Date verschoben
+		if date.Day() != candidate.day || int(date.Month()) != candidate.month {
+			continue
+		}
+		if slices.ContainsFunc(patterns, func(pattern datePattern) bool { return pattern.date.Equal(date) }) {
+			continue
+		}
+		patterns = append(patterns, datePattern{
+			id:    "palindrome-" + date.Format("20060102"),
+			label: "Palindrom-Datum",
+			emoji: "🔁",
+			date:  date,
+			notes: []string{fmt.Sprintf("Ohne Trennzeichen liest sich %s (%s) vorwärts und rückwärts gleich.", date.Format(candidate.format), date.Format(candidate.digits))},
+		})
+	}
+	return patterns
+}
+
+// findDatePatterns sucht die Tage, die wegen ihres Kalenderdatums besonders sind, ab dem
+// Ankerdatum bis zum 100. Jahrestag. Die erste Notiz erklärt das Muster, danach folgen die
+// Hinweise zum 29. Februar.
+func findDatePatterns(birth time.Time, opts Options) []datePattern {
+	limit := birth.AddDate(maxPatternAgeYears, 0, 0)
+	var patterns []datePattern
+	for years := 1; years <= maxPatternAgeYears; years++ {
+		notes, skip := leapDayNotes(birth, years, 0, opts)
+		if skip {
+			continue
+		}
+		birthday := addPeriodValues(birth, [4]int{years, 0, 0, 0}, opts)
+
+		// Der goldene Geburtstag bezieht sich auf das Alter und gibt es nur bei Geburten
+		if years == birth.Day() && anchorHasAge(opts.Anchor) {
+			patterns = append(patterns, datePattern{
+				id:    "golden-birthday",
+				label: "Goldener Geburtstag",
+				emoji: "🌟",
+				date:  birthday,
+				notes: append([]string{fmt.Sprintf("Das Alter in Jahren (%d) ist gleich dem Tag der Geburt im Monat.", years)}, notes...),
+			})
+		}
+		if birthday.Weekday() == birth.Weekday() {
+			weekday := weekdayNames[birth.Weekday()]
+			patterns = append(patterns, datePattern{
+				id:    fmt.Sprintf("weekday-%d", years),
+				label: display.GetAnniversaryWeekday(opts.Anchor, years, weekday),
+				emoji: "📅",
+				date:  birthday,
+				notes: append([]string{display.GetAnniversaryWeekdayDescription(opts.Anchor, weekday)}, notes...),
+			})
+		}
+	}
+	for year := birth.Year(); year <= limit.Year(); year++ {
+		for _, pattern := range palindromeDates(year, birth.Location()) {
+			if dateKey(pattern.date) >= dateKey(birth) && !pattern.date.After(limit) {
+				patterns = append(patterns, pattern)
+			}
+		}
+	}
+	return patterns
+}
+
+// applyDatePatterns ist ein Durchgang über die fertigen Ergebnisse von CalculateResults. Fällt
+// ein besonderes Kalenderdatum auf einen Tag, an dem schon Einträge liegen, bekommen diese einen
+// Hinweis. Sonst wird ein eigener Eintrag in der Kategorie date-patterns ergänzt.
+func applyDatePatterns(results []models.ResultEntry, birth time.Time, excludedCategories []string, opts Options) []models.ResultEntry {
+	if slices.Contains(excludedCategories, categoryDatePatterns) {
+		return results
+	}
+
+	// Nur die vorhandenen Einträge werden ergänzt, nicht die Einträge dieses Durchgangs
+	byDate := map[string][]int{}
+	for i, result := range results {
+		key := dateKey(result.ResultDate)
+		byDate[key] = append(byDate[key], i)
+	}
+
+	for _, pattern := range findDatePatterns(birth, opts) {
+		if indices := byDate[dateKey(pattern.date)]; len(indices) > 0 {
+			note := fmt.Sprintf("%s: %s", pattern.label, pattern.notes[0])
+			for _, i := range indices {
+				// Die Notizen können mit anderen Einträgen geteilt sein
+				results[i].Notes = append(slices.Clip(results[i].Notes), note)
+			}
+			continue
+		}
+
+		results = append(results, models.ResultEntry{
+			OriginalValues: models.TimePeriod{
+				Categories: []string{categoryDatePatterns},
+				Emoji:      pattern.emoji,
+			},
+			ResultDate:          pattern.date,
+			FormattedDate:       pattern.date.Format("02.01.2006"),
+			ResultId:            "pattern-" + pattern.id,
+			FormattedTimePeriod: pattern.label,
+			DaysBetween:         daysBetween(birth, pattern.date),
+			Emoji:               pattern.emoji,
+			Categories:          []string{categoryDatePatterns},
+			Notes:               pattern.notes,
+		})
+	}
+	return results
+}
@@ -0,0 +1,95 @@
+package processor
+
+import (
+	"baby-calendar/models"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPalindromeDates(t *testing.T) {
+	tests := []struct {
+		year int
+		want []string
+	}{
+		// 12022021 und 20211202
+		{2021, []string{"2021-02-12", "2021-12-02"}},
+		// 22022022 und 20220222 sind derselbe Tag
+		{2022, []string{"2022-02-22"}},
+		// Beide Formate ergäben den 32. Februar
+		{2023, nil},
+		// 03022030 und 20300302
+		{2030, []string{"2030-02-03", "2030-03-02"}},
+	}
+	for _, tt := range tests {
+		var got []string
+		for _, pattern := range palindromeDates(tt.year, time.UTC) {
+			got = append(got, pattern.date.Format("2006-01-02"))
+		}
+		if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+			t.Errorf("palindromeDates(%d) = %v, want %v", tt.year, got, tt.want)
+		}
+	}
+}
+
+func TestApplyDatePatterns(t *testing.T) {
+	// Der 14.02.2015 war ein Samstag, der 11. Geburtstag am 14.02.2026 ebenfalls
+	birth := date(2015, time.February, 14)
+	birthday := models.ResultEntry{ResultId: "11-0-0-0", ResultDate: date(2026, time.February, 14), Categories: []string{"birthday"}}
+
+	t.Run("annotates existing entries", func(t *testing.T) {
+		results := applyDatePatterns([]models.ResultEntry{birthday}, birth, nil, Options{})
+		if len(results[0].Notes) != 1 || !strings.HasPrefix(results[0].Notes[0], "11. Geburtstag an einem Samstag: ") {
+			t.Errorf("birthday notes = %q, want weekday note", results[0].Notes)
+		}
+		for _, result := range results {
+			if result.ResultId == "pattern-weekday-11" {
+				t.Error("pattern-weekday-11 added although the birthday is in the results")
+			}
+		}
+		if birthday.Notes != nil {
+			t.Error("input entry was modified")
+		}
+	})
+
+	t.Run("adds entries on free days", func(t *testing.T) {
+		results := applyDatePatterns(nil, birth, nil, Options{})
+		ids := map[string]time.Time{}
+		for _, result := range results {
+			ids[result.ResultId] = result.ResultDate
+			if result.Categories[0] != categoryDatePatterns {
+				t.Errorf("%s has categories %v", result.ResultId, result.Categories)
+			}
+		}
+		want := map[string]time.Time{
+			"pattern-weekday-11":          date(2026, time.February, 14),
+			"pattern-golden-birthday":     date(2029, time.February, 14),
+			"pattern-palindrome-20211202": date(2021, time.December, 2),
+			"pattern-palindrome-20220222": date(2022, time.February, 22),
+		}
+		for id, wantDate := range want {
+			if got, ok := ids[id]; !ok || !got.Equal(wantDate) {
+				t.Errorf("%s = %s, want %s", id, got.Format("2006-01-02"), wantDate.Format("2006-01-02"))
+			}
+		}
+		// Palindrome vor der Geburt gehören nicht dazu
+		if _, ok := ids["pattern-palindrome-20100102"]; ok {
+			t.Error("palindrome before birth included")
+		}
+	})
+
+	t.Run("excluded", func(t *testing.T) {
+		results := applyDatePatterns([]models.ResultEntry{birthday}, birth, []string{categoryDatePatterns}, Options{})
+		if len(results) != 1 || results[0].Notes != nil {
+			t.Errorf("got %d results with notes %q, want the input unchanged", len(results), results[0].Notes)
+		}
+	})
+
+	t.Run("golden birthday only with age", func(t *testing.T) {
+		for _, result := range applyDatePatterns(nil, birth, nil, Options{Anchor: models.AnchorWedding}) {
+			if result.ResultId == "pattern-golden-birthday" {
+				t.Error("golden birthday for a wedding anchor")
+			}
+		}
+	})
+}
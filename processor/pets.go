@@ -0,0 +1,204 @@
+package processor
+
+import (
+	"baby-calendar/datecalc"
+	"baby-calendar/display"
+	"baby-calendar/models"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Kategorien der Haustiere: die Hunde- und Katzenjahre an jedem Jahrestag und die Tage, an
+// denen das Tier umgerechnet ein rundes Alter in Menschenjahren erreicht
+const (
+	categoryPetYears    = "pet-years"
+	categoryPetHumanAge = "pet-human-age"
+)
+
+// Haustiere werden bis zu diesem Alter in Jahren berechnet
+const maxPetAgeYears = 30
+
+// Abstand der Meilensteine in Menschenjahren
+const petHumanAgeStep = 5
+
+// species ist eine Tierart mit einer veröffentlichten Umrechnung in Menschenjahre.
+// humanAge rechnet ein Alter in Jahren in Menschenjahre um, petAge ist die Umkehrung.
+type species struct {
+	id          string
+	description string // z.B. "mittelgroßer Hund, 9–23 kg"
+	yearWord    string // Bestimmungswort für die Jahre, z.B. "Hunde" für "Hundejahr"
+	emoji       string
+	source      string
+	humanAge    func(years float64) float64
+	petAge      func(humanYears float64) float64
+}
+
+// Menschenjahre am 1. bis 16. Geburtstag nach der Tabelle der American Veterinary Medical
+// Association (AVMA) für Hunde nach Gewicht
+var (
+	dogSmallTable  = []float64{15, 24, 28, 32, 36, 40, 44, 48, 52, 56, 60, 64, 68, 72, 76, 80}
+	dogMediumTable = []float64{15, 24, 28, 32, 36, 42, 47, 51, 56, 60, 65, 69, 74, 78, 83, 87}
+	dogLargeTable  = []float64{15, 24, 28, 32, 36, 45, 50, 55, 61, 66, 72, 77, 82, 88, 93, 99}
+	dogGiantTable  = []float64{12, 22, 31, 38, 45, 49, 56, 64, 71, 79, 86, 93, 100, 107, 114, 121}
+)
+
+// Menschenjahre am 1. und 2. Geburtstag einer Katze, danach kommen je Jahr vier hinzu
+// (AAHA/AAFP Feline Life Stage Guidelines)
+var catTable = []float64{15, 24, 28}
+
+const avmaSource = "American Veterinary Medical Association (AVMA), Umrechnung des Hundealters nach Gewicht"
+
+// Tierarten in der Reihenfolge für die Parameterbeschreibung
+var petSpecies = []species{
+	{
+		id:          "dog",
+		description: "Hund, epigenetische Uhr",
+		yearWord:    "Hunde",
+		emoji:       "🐶",
+		source:      "Wang et al., Quantitative Translation of Dog-to-Human Aging by Conserved Remodeling of the DNA Methylome, Cell Systems 2020: 16 · ln(Alter) + 31",
+		humanAge:    func(years float64) float64 { return 16*math.Log(years) + 31 },
+		petAge:      func(humanYears float64) float64 { return math.Exp((humanYears - 31) / 16) },
+	},
+	tableSpecies("dog-small", "kleiner Hund, bis 9 kg", "Hunde", "🐶", avmaSource, dogSmallTable),
+	tableSpecies("dog-medium", "mittelgroßer Hund, 9–23 kg", "Hunde", "🐶", avmaSource, dogMediumTable),
+	tableSpecies("dog-large", "großer Hund, 23–45 kg", "Hunde", "🐶", avmaSource, dogLargeTable),
+	tableSpecies("dog-giant", "sehr großer Hund, über 45 kg", "Hunde", "🐶", avmaSource, dogGiantTable),
+	tableSpecies("cat", "Katze", "Katzen", "🐱", "AAHA/AAFP Feline Life Stage Guidelines 2021", catTable),
+}
+
+// tableSpecies erstellt eine Tierart aus einer Tabelle der Menschenjahre an den Geburtstagen.
+// Dazwischen wird linear interpoliert, nach der Tabelle mit dem letzten Abstand fortgeschrieben.
+func tableSpecies(id, description, yearWord, emoji, source string, table []float64) species {
+	// Menschenjahre am Geburtstag years, beginnend bei 0 am Tag der Geburt
+	at := func(years int) float64 {
+		if years == 0 {
+			return 0
+		}
+		last := len(table) - 1
+		if years <= len(table) {
+			return table[years-1]
+		}
+		return table[last] + float64(years-len(table))*(table[last]-table[last-1])
+	}
+	return species{
+		id:          id,
+		description: description,
+		yearWord:    yearWord,
+		emoji:       emoji,
+		source:      source,
+		humanAge: func(years float64) float64 {
+			whole := int(years)
+			return at(whole) + (years-float64(whole))*(at(whole+1)-at(whole))
+		},
+		petAge: func(humanYears float64) float64 {
+			whole := 0
+			for at(whole+1) <= humanYears {
+				whole++
+			}
+			return float64(whole) + (humanYears-at(whole))/(at(whole+1)-at(whole))
+		},
+	}
+}
+
+// ParseSpecies liest die Tierart aus einem Parameterwert
+func ParseSpecies(value string) (string, error) {
+	ids := make([]string, 0, len(petSpecies))
+	for _, species := range petSpecies {
+		ids = append(ids, species.id)
+	}
+	if !slices.Contains(ids, value) {
+		return "", fmt.Errorf("Invalid species value %q. Use %s.", value, strings.Join(ids, ", "))
+	}
+	return value, nil
+}
+
+// getSpecies liefert die Tierart mit der ID id
+func getSpecies(id string) (species, bool) {
+	index := slices.IndexFunc(petSpecies, func(species species) bool { return species.id == id })
+	if index < 0 {
+		return species{}, false
+	}
+	return petSpecies[index], true
+}
+
+// petAgeDate liefert den Tag, an dem das Tier das Alter years erreicht. Der Bruchteil eines
+// Jahres wird auf die Tage bis zum nächsten Jahrestag verteilt.
+func petAgeDate(birth time.Time, years float64, opts Options) time.Time {
+	whole := int(years)
+	start := datecalc.AddPeriod(birth, whole, 0, 0, 0, opts.Policy)
+	end := datecalc.AddPeriod(birth, whole+1, 0, 0, 0, opts.Policy)
+	return start.AddDate(0, 0, int(math.Round((years-float64(whole))*float64(daysBetween(start, end)))))
+}
+
+// calculatePetResults berechnet für die Tierart in den Optionen die Hunde- bzw. Katzenjahre und
+// die Tage mit einem runden Alter in Menschenjahren
+func calculatePetResults(birth time.Time, excludedCategories []string, opts Options) []models.ResultEntry {
+	species, ok := getSpecies(opts.Species)
+	if !ok {
+		return nil
+	}
+
+	var results []models.ResultEntry
+	if !slices.Contains(excludedCategories, categoryPetYears) {
+		for years := 1; years <= maxPetAgeYears; years++ {
+			notes, skip := leapDayNotes(birth, years, 0, opts)
+			if skip {
+				continue
+			}
+			date := datecalc.AddPeriod(birth, years, 0, 0, 0, opts.Policy)
+			humanYears := int(math.Round(species.humanAge(float64(years))))
+			description := display.GetPetYearsDescription(years, species.yearWord, humanYears, species.description)
+			notes = append([]string{display.GetSourcedDescription(description, species.source)}, notes...)
+
+			results = append(results, models.ResultEntry{
+				OriginalValues: models.TimePeriod{
+					Categories: []string{categoryPetYears},
+					Emoji:      species.emoji,
+				},
+				ResultDate:          date,
+				FormattedDate:       date.Format("02.01.2006"),
+				ResultId:            fmt.Sprintf("pet-years-%s-%d", species.id, years),
+				FormattedTimePeriod: display.GetPetYears(years, species.yearWord),
+				DaysBetween:         daysBetween(birth, date),
+				Emoji:               species.emoji,
+				Categories:          []string{categoryPetYears},
+				Notes:               notes,
+			})
+		}
+	}
+
+	if !slices.Contains(excludedCategories, categoryPetHumanAge) {
+		maxHumanYears := species.humanAge(maxPetAgeYears)
+		for humanYears := petHumanAgeStep; float64(humanYears) <= maxHumanYears; humanYears += petHumanAgeStep {
+			years := species.petAge(float64(humanYears))
+			// Nennt ein Jahrestag dieses Alter schon bei den Hunde- bzw. Katzenjahren, entfällt der Eintrag.
+			// Verglichen wird mit den gerundeten Menschenjahren des Jahrestags, weil petAge nicht genau
+			// ganze Jahre liefern muss.
+			whole := int(math.Round(years))
+			if whole >= 1 && whole <= maxPetAgeYears && int(math.Round(species.humanAge(float64(whole)))) == humanYears &&
+				!slices.Contains(excludedCategories, categoryPetYears) {
+				continue
+			}
+			date := petAgeDate(birth, years, opts)
+
+			results = append(results, models.ResultEntry{
+				OriginalValues: models.TimePeriod{
+					Categories: []string{categoryPetHumanAge},
+					Emoji:      species.emoji,
+				},
+				ResultDate:          date,
+				FormattedDate:       date.Format("02.01.2006"),
+				ResultId:            fmt.Sprintf("pet-human-age-%s-%d", species.id, humanYears),
+				FormattedTimePeriod: display.GetHumanYears(humanYears),
+				DaysBetween:         daysBetween(birth, date),
+				Emoji:               species.emoji,
+				Categories:          []string{categoryPetHumanAge},
+				Notes:               []string{display.GetSourcedDescription(display.GetPetHumanAgeDescription(humanYears, species.description), species.source)},
+			})
+		}
+	}
+	return results
+}
@@ -0,0 +1,92 @@
+package processor
+
+import (
+	"baby-calendar/models"
+	"math"
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestSpeciesHumanAge(t *testing.T) {
+	tests := []struct {
+		species string
+		years   float64
+		want    float64
+	}{
+		{"cat", 1, 15},
+		{"cat", 2, 24},
+		{"cat", 5, 36},
+		{"cat", 1.5, 19.5},
+		{"dog-medium", 10, 60},
+		// Nach der Tabelle wird mit dem letzten Abstand fortgeschrieben
+		{"dog-medium", 17, 91},
+		{"dog", 1, 31},
+	}
+	for _, tt := range tests {
+		species, _ := getSpecies(tt.species)
+		got := species.humanAge(tt.years)
+		if math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s humanAge(%v) = %v, want %v", tt.species, tt.years, got, tt.want)
+		}
+		if back := species.petAge(got); math.Abs(back-tt.years) > 1e-9 {
+			t.Errorf("%s petAge(%v) = %v, want %v", tt.species, got, back, tt.years)
+		}
+	}
+}
+
+func TestCalculatePetResults(t *testing.T) {
+	birth := date(2020, time.May, 1)
+	results := calculatePetResults(birth, nil, Options{Anchor: models.AnchorPet, Species: "cat"})
+
+	byID := map[string]models.ResultEntry{}
+	for _, result := range results {
+		byID[result.ResultId] = result
+	}
+	if got := byID["pet-years-cat-2"]; got.FormattedTimePeriod != "2 Katzenjahre" || !got.ResultDate.Equal(date(2022, time.May, 1)) {
+		t.Errorf("pet-years-cat-2 = %q on %s", got.FormattedTimePeriod, got.ResultDate.Format("2006-01-02"))
+	}
+	// 25 Menschenjahre nach einem Viertel des dritten Jahres
+	if got := byID["pet-human-age-cat-25"]; !got.ResultDate.Equal(date(2022, time.July, 31)) {
+		t.Errorf("pet-human-age-cat-25 on %s, want 2022-07-31", got.ResultDate.Format("2006-01-02"))
+	}
+	// 15 Menschenjahre stehen schon beim ersten Katzenjahr
+	if _, ok := byID["pet-human-age-cat-15"]; ok {
+		t.Error("pet-human-age-cat-15 duplicates the first cat year")
+	}
+
+	// Auch wenn petAge nicht genau ganze Jahre liefert, entfällt das Alter am Jahrestag
+	for _, result := range calculatePetResults(birth, nil, Options{Anchor: models.AnchorPet, Species: "dog-medium"}) {
+		if result.ResultId == "pet-human-age-dog-medium-60" {
+			t.Error("pet-human-age-dog-medium-60 duplicates the 10th dog year")
+		}
+	}
+
+	// Ohne Hunde- und Katzenjahre gibt es den Tag mit dem Alter dagegen
+	results = calculatePetResults(birth, []string{categoryPetYears}, Options{Anchor: models.AnchorPet, Species: "cat"})
+	if !slices.ContainsFunc(results, func(result models.ResultEntry) bool { return result.ResultId == "pet-human-age-cat-15" }) {
+		t.Error("pet-human-age-cat-15 missing without pet years")
+	}
+}
+
+func TestCalculateResultsPetYearsReplaceBirthdays(t *testing.T) {
+	data := loadDataSets(t)
+	birth := date(2020, time.May, 1)
+	opts := Options{Anchor: models.AnchorPet, Species: "cat"}
+
+	hasBirthday := func(excluded []string) (birthday, petYears bool) {
+		for _, result := range CalculateResults(data, birth, excluded, opts) {
+			birthday = birthday || slices.Contains(result.Categories, "birthday")
+			petYears = petYears || slices.Contains(result.Categories, categoryPetYears)
+		}
+		return birthday, petYears
+	}
+
+	if birthday, petYears := hasBirthday(nil); birthday || !petYears {
+		t.Errorf("with species: birthday %v, pet years %v, want only pet years", birthday, petYears)
+	}
+	// Mit exclude-pet-years erscheinen die Jahrestage wie ohne Tierart
+	if birthday, petYears := hasBirthday([]string{categoryPetYears}); !birthday || petYears {
+		t.Errorf("without pet years: birthday %v, pet years %v, want only birthdays", birthday, petYears)
+	}
+}
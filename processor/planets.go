@@ -0,0 +1,133 @@
+package processor
+
+import (
+	"baby-calendar/display"
+	"baby-calendar/models"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Kategorie der Geburtstage auf anderen Planeten
+const categoryPlanets = "planets"
+
+// planet ist ein Planet mit seiner Umlaufzeit um die Sonne in Erdtagen (siderisch)
+type planet struct {
+	id            string
+	name          string
+	orbitalPeriod float64
+}
+
+// Planeten in der Reihenfolge ihres Abstands zur Sonne
+var planets = []planet{
+	{"mercury", "Merkur", 87.969},
+	{"venus", "Venus", 224.701},
+	{"mars", "Mars", 686.980},
+	{"jupiter", "Jupiter", 4332.589},
+	{"saturn", "Saturn", 10759.22},
+}
+
+// Geburtstage auf Planeten werden bis zu diesem Alter in Erdjahren berechnet
+const maxPlanetAgeYears = 100
+
+// ParsePlanets liest die Planeten aus einem Parameterwert mit Kommas. Ein leerer Wert wählt
+// alle Planeten. Das Ergebnis ist nach dem Abstand zur Sonne sortiert.
+func ParsePlanets(value string) ([]string, error) {
+	ids := make([]string, 0, len(planets))
+	for _, planet := range planets {
+		ids = append(ids, planet.id)
+	}
+	if value == "" {
+		return ids, nil
+	}
+
+	var selected []string
+	for _, id := range strings.Split(strings.ToLower(value), ",") {
+		if !slices.Contains(ids, id) {
+			return nil, fmt.Errorf("Invalid planets value %q. Use a comma-separated list of %s.", value, strings.Join(ids, ", "))
+		}
+		if !slices.Contains(selected, id) {
+			selected = append(selected, id)
+		}
+	}
+	slices.SortFunc(selected, func(a, b string) int {
+		return slices.Index(ids, a) - slices.Index(ids, b)
+	})
+	return selected, nil
+}
+
+// selectedPlanets liefert die Planeten aus den Optionen
+func selectedPlanets(opts Options) []planet {
+	var selected []planet
+	for _, planet := range planets {
+		if slices.Contains(opts.Planets, planet.id) {
+			selected = append(selected, planet)
+		}
+	}
+	return selected
+}
+
+// planetBirthday liefert den Tag, an dem das Jahr years auf dem Planeten vollendet ist,
+// gezählt ab Mitternacht des Geburtstags
+func planetBirthday(birth time.Time, p planet, years int) time.Time {
+	return birth.AddDate(0, 0, int(math.Floor(float64(years)*p.orbitalPeriod)))
+}
+
+// calculatePlanetResults berechnet die Geburtstage auf den Planeten in den Optionen
+func calculatePlanetResults(birth time.Time, excludedCategories []string, opts Options) []models.ResultEntry {
+	if slices.Contains(excludedCategories, categoryPlanets) {
+		return nil
+	}
+
+	limit := birth.AddDate(maxPlanetAgeYears, 0, 0)
+	var results []models.ResultEntry
+	for _, planet := range selectedPlanets(opts) {
+		for years := 1; ; years++ {
+			date := planetBirthday(birth, planet, years)
+			if date.After(limit) {
+				break
+			}
+			results = append(results, models.ResultEntry{
+				OriginalValues: models.TimePeriod{
+					Categories: []string{categoryPlanets},
+					Emoji:      "🪐",
+				},
+				ResultDate:          date,
+				FormattedDate:       date.Format("02.01.2006"),
+				ResultId:            fmt.Sprintf("planet-%s-%d", planet.id, years),
+				FormattedTimePeriod: display.GetPlanetYears(years, planet.name),
+				DaysBetween:         daysBetween(birth, date),
+				Emoji:               "🪐",
+				Categories:          []string{categoryPlanets},
+				Notes:               []string{display.GetPlanetDescription(opts.Anchor, planet.name, planet.orbitalPeriod)},
+			})
+		}
+	}
+	return results
+}
+
+// calculatePlanetAges berechnet das Alter am Datum at auf den Planeten in den Optionen
+func calculatePlanetAges(birth, at time.Time, opts Options) []models.PlanetAge {
+	totalDays := daysBetween(birth, at)
+
+	var ages []models.PlanetAge
+	for _, planet := range selectedPlanets(opts) {
+		years := float64(totalDays) / planet.orbitalPeriod
+		completed := int(math.Floor(years))
+		// Der Geburtstag kann auf den Tag fallen, bevor das Jahr rechnerisch vollendet ist
+		for !planetBirthday(birth, planet, completed+1).After(at) {
+			completed++
+		}
+		ages = append(ages, models.PlanetAge{
+			Planet:         planet.id,
+			Name:           planet.name,
+			Years:          math.Round(years*100) / 100,
+			CompletedYears: completed,
+			NextBirthday:   planetBirthday(birth, planet, completed+1).Format("2006-01-02"),
+			Formatted:      display.GetPlanetAge(years, planet.name),
+		})
+	}
+	return ages
+}
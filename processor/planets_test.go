@@ -0,0 +1,81 @@
+package processor
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParsePlanets(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    []string
+		wantErr bool
+	}{
+		{"", []string{"mercury", "venus", "mars", "jupiter", "saturn"}, false},
+		// Sortiert nach dem Abstand zur Sonne, doppelte Werte zählen einmal
+		{"Mars,mercury,mars", []string{"mercury", "mars"}, false},
+		{"pluto", nil, true},
+	}
+	for _, tt := range tests {
+		got, err := ParsePlanets(tt.value)
+		if (err != nil) != tt.wantErr || !slices.Equal(got, tt.want) {
+			t.Errorf("ParsePlanets(%q) = %v, %v, want %v", tt.value, got, err, tt.want)
+		}
+	}
+}
+
+func TestCalculatePlanetResults(t *testing.T) {
+	birth := date(2025, time.January, 1)
+	results := calculatePlanetResults(birth, nil, Options{Planets: []string{"mercury", "mars", "jupiter"}})
+
+	dates := map[string]time.Time{}
+	for _, result := range results {
+		if _, ok := dates[result.ResultId]; ok {
+			t.Errorf("duplicate result ID %q", result.ResultId)
+		}
+		dates[result.ResultId] = result.ResultDate
+	}
+	want := map[string]time.Time{
+		// 87,969 Tage, der Geburtstag ist der Tag, an dem das Jahr vollendet ist
+		"planet-mercury-1": date(2025, time.March, 29),
+		"planet-mercury-2": date(2025, time.June, 25),
+		"planet-mars-1":    date(2026, time.November, 18),
+		"planet-jupiter-1": date(2036, time.November, 11),
+	}
+	for id, wantDate := range want {
+		if got, ok := dates[id]; !ok || !got.Equal(wantDate) {
+			t.Errorf("%s = %s, want %s", id, got.Format("2006-01-02"), wantDate.Format("2006-01-02"))
+		}
+	}
+	// Nur bis zum 100. Lebensjahr
+	if _, ok := dates["planet-jupiter-9"]; ok {
+		t.Error("planet-jupiter-9 after 100 years")
+	}
+	if _, ok := dates["planet-venus-1"]; ok {
+		t.Error("planet-venus-1 not selected")
+	}
+
+	if results := calculatePlanetResults(birth, []string{categoryPlanets}, Options{Planets: []string{"mars"}}); results != nil {
+		t.Errorf("excluded planets category returned %d results", len(results))
+	}
+}
+
+func TestCalculatePlanetAges(t *testing.T) {
+	birth := date(2025, time.January, 1)
+	tests := []struct {
+		at        time.Time
+		completed int
+		next      string
+	}{
+		{date(2025, time.March, 28), 0, "2025-03-29"},
+		// Am Geburtstag ist das Jahr vollendet, auch wenn 87 Tage rechnerisch knapp darunter liegen
+		{date(2025, time.March, 29), 1, "2025-06-25"},
+	}
+	for _, tt := range tests {
+		ages := calculatePlanetAges(birth, tt.at, Options{Planets: []string{"mercury"}})
+		if len(ages) != 1 || ages[0].CompletedYears != tt.completed || ages[0].NextBirthday != tt.next {
+			t.Errorf("calculatePlanetAges(%s) = %+v, want %d completed, next %s", tt.at.Format("2006-01-02"), ages, tt.completed, tt.next)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package processor
+
+import (
+	"baby-calendar/display"
+	"baby-calendar/models"
+	"slices"
+	"time"
+)
+
+// Kategorie aller Einträge, die ab dem errechneten Termin gezählt werden (Schwangerschaftswochen,
+// Trimester, Countdown)
+const categoryPregnancy = "pregnancy"
+
+// DueFromLastPeriod berechnet den errechneten Termin aus dem ersten Tag der letzten Periode
+// nach der Naegele-Regel (280 Tage)
+func DueFromLastPeriod(lmp time.Time) time.Time {
+	return lmp.AddDate(0, 0, pregnancyDays)
+}
+
+// calculatePregnancyResults berechnet die Einträge der Schwangerschaft ab dem errechneten Termin.
+// Ist das Kind schon geboren, bleiben nur die Einträge vor der Geburt erhalten, sodass der
+// Kalender nahtlos von der Schwangerschaft zu den Meilensteinen ab der Geburt übergeht.
+func calculatePregnancyResults(timePeriods []models.TimePeriod, birth time.Time, excludedCategories []string, opts Options) []models.ResultEntry {
+	if opts.Due.IsZero() || slices.Contains(excludedCategories, categoryPregnancy) {
+		return nil
+	}
+
+	var pregnancyPeriods []models.TimePeriod
+	for _, period := range timePeriods {
+		if slices.Contains(period.Categories, categoryPregnancy) {
+			pregnancyPeriods = append(pregnancyPeriods, period)
+		}
+	}
+
+	due := inLocationOf(opts.Due, birth)
+
+	var results []models.ResultEntry
+	for _, result := range calculatePeriodResults(pregnancyPeriods, due, birth, excludedCategories, opts) {
+		if !opts.Unborn && result.DaysBetween >= 0 {
+			continue
+		}
+		result.Description = display.GetPregnancyDescription(daysBetween(result.ResultDate, due), due)
+		results = append(results, result)
+	}
+	return results
+}
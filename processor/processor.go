@@ -1,16 +1,113 @@
 package processor
 
 import (
+	"baby-calendar/datecalc"
+	"baby-calendar/display"
 	"baby-calendar/models"
+	"bytes"
 	"encoding/json"
 	"fmt"
+	"math/bits"
 	"os"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 )
 
+// Options enthält die Einstellungen für die Berechnung. Der Nullwert entspricht dem
+// bisherigen Verhalten.
+type Options struct {
+	datecalc.Policy
+	Due     time.Time // Errechneter Geburtstermin, optional
+	AgeMode AgeMode
+	Unborn  bool // Das Kind ist noch nicht geboren, birth ist der errechnete Termin
+	// Tage vor Beginn einer Vorsorgeuntersuchung, an denen an die Terminvereinbarung erinnert
+	// wird. 0 bedeutet keine Erinnerung.
+	CheckupReminderDays int
+	// Kürzel des Bundeslands für Kita und Schule, z.B. "BY"
+	State string
+	// Ländercode für rechtliche Altersgrenzen, z.B. "DE"
+	Country string
+	// Bereits gegebene Impfdosen mit Datum, Schlüssel ist "<Impfstoff>-<Dosis>" (z.B. "6fach-1")
+	GivenDoses map[string]time.Time
+	// Planeten für Geburtstage auf anderen Planeten, z.B. "mars"
+	Planets []string
+	// Kalendersysteme für Geburtstage nach anderen Kalendern, z.B. "hebrew"
+	Calendars []string
+	// Art des Ankerereignisses, das Datum in birth ist z.B. der Hochzeitstag
+	Anchor models.AnchorType
+	// Tierart für Haustiere, z.B. "dog-medium" oder "cat"
+	Species string
+	// Name der Person des Kalenders und weitere Personen der Familie für die Meilensteine
+	// zwischen Personen (relations)
+	Name      string
+	Relatives []models.Person
+}
+
+// DataSets enthält alle Datensätze, die einmalig beim Start geladen werden
+type DataSets struct {
+	TimePeriods  []models.TimePeriod
+	Checkups     []models.Checkup
+	Vaccinations []models.Vaccination
+	School       models.SchoolRules
+	Legal        models.LegalRules
+}
+
+// Fingerprint liefert die vom Standard abweichenden Einstellungen für den Cache-Dateinamen
+func (o Options) Fingerprint() []string {
+	var fingerprint []string
+	if o.MonthEnd != "" && o.MonthEnd != datecalc.MonthEndNormalize {
+		fingerprint = append(fingerprint, fmt.Sprintf("month-end-%s", o.MonthEnd))
+	}
+	if o.LeapDay != datecalc.LeapDayDefault {
+		fingerprint = append(fingerprint, fmt.Sprintf("leap-day-%s", o.LeapDay))
+	}
+	if !o.Due.IsZero() {
+		fingerprint = append(fingerprint, fmt.Sprintf("due-%s", o.Due.Format("2006-01-02")))
+	}
+	if o.AgeMode != AgeModeAuto {
+		fingerprint = append(fingerprint, fmt.Sprintf("age-%s", o.AgeMode))
+	}
+	if o.Anchor != models.AnchorBirth {
+		fingerprint = append(fingerprint, fmt.Sprintf("anchor-%s", o.Anchor))
+	}
+	if o.Species != "" {
+		fingerprint = append(fingerprint, fmt.Sprintf("species-%s", o.Species))
+	}
+	if o.Unborn {
+		fingerprint = append(fingerprint, "unborn")
+	}
+	if o.CheckupReminderDays > 0 {
+		fingerprint = append(fingerprint, fmt.Sprintf("checkup-reminder-%d", o.CheckupReminderDays))
+	}
+	if o.State != "" {
+		fingerprint = append(fingerprint, fmt.Sprintf("state-%s", o.State))
+	}
+	if o.Country != "" {
+		fingerprint = append(fingerprint, fmt.Sprintf("country-%s", o.Country))
+	}
+	if len(o.Planets) > 0 {
+		fingerprint = append(fingerprint, fmt.Sprintf("planets-%s", strings.Join(o.Planets, "-")))
+	}
+	if len(o.Calendars) > 0 {
+		fingerprint = append(fingerprint, fmt.Sprintf("calendars-%s", strings.Join(o.Calendars, "-")))
+	}
+	for _, relative := range o.Relatives {
+		fingerprint = append(fingerprint, fmt.Sprintf("relative-%s-%s-%s", relative.Name, relative.Role, relative.Birth.Format("20060102")))
+	}
+	doses := make([]string, 0, len(o.GivenDoses))
+	for dose := range o.GivenDoses {
+		doses = append(doses, dose)
+	}
+	sort.Strings(doses)
+	for _, dose := range doses {
+		fingerprint = append(fingerprint, fmt.Sprintf("given-%s-%s", dose, o.GivenDoses[dose].Format("20060102")))
+	}
+	return fingerprint
+}
+
 // LoadTimePeriods lädt die Zeitperioden aus der JSON-Datei
 func LoadTimePeriods(filePath string) ([]models.TimePeriod, error) {
 	// Datei öffnen
@@ -26,89 +123,273 @@ func LoadTimePeriods(filePath string) ([]models.TimePeriod, error) {
 		return nil, fmt.Errorf("Fehler beim Lesen der Datei: %w", err)
 	}
 
-	// JSON in ein Array von TimePeriod-Strukturen umwandeln
-	var rawData [][]interface{}
+	// JSON in ein Array von Einträgen umwandeln, die Umwandlung in TimePeriod-Strukturen folgt
+	var rawData []json.RawMessage
 	err = json.Unmarshal(byteValue, &rawData)
 	if err != nil {
 		return nil, fmt.Errorf("Fehler beim Unmarshalling des JSON: %w", err)
 	}
 
+	return parseTimePeriods(rawData, periodResultID)
+}
+
+// parseTimePeriods wandelt die Einträge in Zeitperioden um. Einträge im Listenformat [Jahr, Monat,
+// Woche, Tag, [Kategorien], Emoji, Bezeichnung, Zeitraum] ergeben eine Zeitperiode, Objekte sind
+// Generatoren für eine ganze Familie von Zeitperioden (siehe generatorSpec). Die ID aus resultID
+// wird zur UID im Kalender und muss daher eindeutig sein.
+func parseTimePeriods(rawData []json.RawMessage, resultID func(models.TimePeriod) string) ([]models.TimePeriod, error) {
 	var periods []models.TimePeriod
-	for _, raw := range rawData {
-		period := models.TimePeriod{
-			Categories: []string{}, // Stelle sicher, dass Categories immer initialisiert ist
+	ids := map[string]bool{}
+	for index, message := range rawData {
+		parsed, err := parseTimePeriod(message)
+		if err != nil {
+			return nil, fmt.Errorf("Fehler in Eintrag %d: %w", index+1, err)
 		}
-
-		// Die ersten 4 Elemente sind die Werte
-		for i := 0; i < 4 && i < len(raw); i++ {
-			if num, ok := raw[i].(float64); ok {
-				period.Values[i] = int(num)
+		for _, period := range parsed {
+			id := resultID(period)
+			if ids[id] {
+				return nil, fmt.Errorf("Fehler in Eintrag %d: ID %s ist doppelt", index+1, id)
 			}
+			ids[id] = true
 		}
+		periods = append(periods, parsed...)
+	}
 
-		// Das 5. Element ist die Kategorienliste
-		if len(raw) > 4 {
-			if cats, ok := raw[4].([]interface{}); ok {
-				period.Categories = make([]string, len(cats))
-				for i, cat := range cats {
-					if str, ok := cat.(string); ok {
-						period.Categories[i] = str
-					}
+	return periods, nil
+}
+
+// parseTimePeriod wandelt einen Eintrag in eine Zeitperiode oder bei einem Generator in mehrere um
+func parseTimePeriod(message json.RawMessage) ([]models.TimePeriod, error) {
+	if trimmed := bytes.TrimSpace(message); len(trimmed) > 0 && trimmed[0] == '{' {
+		var spec generatorSpec
+		if err := json.Unmarshal(trimmed, &spec); err != nil {
+			return nil, err
+		}
+		return expandGenerator(spec)
+	}
+
+	var raw []interface{}
+	if err := json.Unmarshal(message, &raw); err != nil {
+		return nil, err
+	}
+	period := models.TimePeriod{
+		Categories: []string{}, // Stelle sicher, dass Categories immer initialisiert ist
+	}
+
+	// Die ersten 4 Elemente sind die Werte
+	for i := 0; i < 4 && i < len(raw); i++ {
+		if num, ok := raw[i].(float64); ok {
+			period.Values[i] = int(num)
+		}
+	}
+
+	// Negative Werte zählen rückwärts vom Ankerdatum, gemischte Vorzeichen sind nicht eindeutig
+	if hasMixedSigns(period.Values) {
+		return nil, fmt.Errorf("Werte %v haben unterschiedliche Vorzeichen", period.Values)
+	}
+
+	// Das 5. Element ist die Kategorienliste
+	if len(raw) > 4 {
+		if cats, ok := raw[4].([]interface{}); ok {
+			period.Categories = make([]string, len(cats))
+			for i, cat := range cats {
+				if str, ok := cat.(string); ok {
+					period.Categories[i] = str
 				}
 			}
 		}
+	}
 
-		// Das 6. Element ist der optionale Emoji
-		period.Emoji = "✨"
-		if len(raw) > 5 {
-			if emoji, ok := raw[5].(string); ok {
-				period.Emoji = emoji
-			}
+	// Das 6. Element ist der optionale Emoji
+	period.Emoji = "✨"
+	if len(raw) > 5 {
+		if emoji, ok := raw[5].(string); ok {
+			period.Emoji = emoji
 		}
+	}
 
-		periods = append(periods, period)
+	// Das 7. Element ist die optionale Bezeichnung
+	if len(raw) > 6 {
+		if label, ok := raw[6].(string); ok {
+			period.Label = label
+		}
 	}
 
-	return periods, nil
+	// Das 8. Element macht den Eintrag mehrtägig: {"end": [Jahr, Monat, Woche, Tag]} ab dem
+	// Ankerdatum oder {"duration": [Jahr, Monat, Woche, Tag]} ab dem Beginn
+	if len(raw) > 7 {
+		if err := parseSpan(raw[7], &period); err != nil {
+			return nil, err
+		}
+		if err := validateSpan(period); err != nil {
+			return nil, err
+		}
+	}
+
+	return []models.TimePeriod{period}, nil
+}
+
+// parseSpan liest das Ende eines mehrtägigen Eintrags aus dem 8. Element
+func parseSpan(raw interface{}, period *models.TimePeriod) error {
+	span, ok := raw.(map[string]interface{})
+	if !ok || len(span) != 1 {
+		return fmt.Errorf("Zeitraum muss ein Objekt mit genau einem der Schlüssel end oder duration sein")
+	}
+	for key, value := range span {
+		values, ok := parseValues(value)
+		if !ok {
+			return fmt.Errorf("%s muss eine Liste mit 4 Zahlen sein", key)
+		}
+		if hasMixedSigns(values) {
+			return fmt.Errorf("Werte %v von %s haben unterschiedliche Vorzeichen", values, key)
+		}
+		switch key {
+		case "end":
+			period.End = &values
+		case "duration":
+			period.Duration = &values
+		default:
+			return fmt.Errorf("Unbekannter Schlüssel %s im Zeitraum", key)
+		}
+	}
+	return nil
+}
+
+// periodDayRange liefert die kleinste und größte Anzahl von Tagen, die eine Periode
+// [Jahr, Monat, Woche, Tag] je nach Länge der Jahre und Monate umfassen kann
+func periodDayRange(values [4]int) (int, int) {
+	bounds := [4][2]int{{365, 366}, {28, 31}, {7, 7}, {1, 1}}
+	minDays, maxDays := 0, 0
+	for i, value := range values {
+		if value >= 0 {
+			minDays += value * bounds[i][0]
+			maxDays += value * bounds[i][1]
+		} else {
+			minDays += value * bounds[i][1]
+			maxDays += value * bounds[i][0]
+		}
+	}
+	return minDays, maxDays
+}
+
+// validateSpan prüft, ob ein mehrtägiger Eintrag nach seinem Beginn endet. Da das Ende exklusiv
+// ist, muss es mindestens zwei Tage nach dem Beginn liegen können.
+func validateSpan(period models.TimePeriod) error {
+	switch {
+	case period.End != nil:
+		startMin, _ := periodDayRange(period.Values)
+		_, endMax := periodDayRange(*period.End)
+		if endMax-startMin < 2 {
+			return fmt.Errorf("Ende %v liegt nicht nach dem Beginn %v", *period.End, period.Values)
+		}
+	case period.Duration != nil:
+		if _, durationMax := periodDayRange(*period.Duration); durationMax < 2 {
+			return fmt.Errorf("Dauer %v ist kürzer als zwei Tage", *period.Duration)
+		}
+	}
+	return nil
 }
 
-func FormatTimePeriod(years, months, weeks, days int) string {
+// parseValues liest eine Periode [Jahr, Monat, Woche, Tag] aus einem JSON-Wert
+func parseValues(raw interface{}) ([4]int, bool) {
+	var values [4]int
+	list, ok := raw.([]interface{})
+	if !ok || len(list) != 4 {
+		return values, false
+	}
+	for i, item := range list {
+		num, ok := item.(float64)
+		if !ok {
+			return values, false
+		}
+		values[i] = int(num)
+	}
+	return values, true
+}
+
+func hasMixedSigns(values [4]int) bool {
+	positive, negative := false, false
+	for _, value := range values {
+		positive = positive || value > 0
+		negative = negative || value < 0
+	}
+	return positive && negative
+}
+
+func abs(value int) int {
+	if value < 0 {
+		return -value
+	}
+	return value
+}
+
+// Notation ist eine besondere Schreibweise der Zahlen in FormatTimePeriod
+type Notation string
+
+const (
+	NotationDecimal    Notation = ""             // 8192 Tage
+	NotationPowerOfTwo Notation = "power-of-two" // 2¹³ Tage
+	NotationHex        Notation = "hex"          // 0x2000 Tage
+)
+
+// Hochgestellte Ziffern für Exponenten
+var superscriptDigits = strings.NewReplacer("0", "⁰", "1", "¹", "2", "²", "3", "³", "4", "⁴", "5", "⁵", "6", "⁶", "7", "⁷", "8", "⁸", "9", "⁹")
+
+// formatNumber schreibt eine Zahl in der Schreibweise n. Bei NotationPowerOfTwo muss value eine
+// Zweierpotenz sein.
+func (n Notation) formatNumber(value int) string {
+	switch n {
+	case NotationPowerOfTwo:
+		return "2" + superscriptDigits.Replace(strconv.Itoa(bits.TrailingZeros64(uint64(value))))
+	case NotationHex:
+		return fmt.Sprintf("0x%X", value)
+	}
+	return strconv.Itoa(value)
+}
+
+// formatCount formatiert eine Anzahl mit ihrer Einheit in Einzahl oder Mehrzahl, z.B. "1 Woche"
+// oder in der Schreibweise NotationPowerOfTwo "2¹⁴ Tage"
+func formatCount(value int, singular, plural string, notation Notation) string {
+	if value == 1 {
+		return notation.formatNumber(value) + " " + singular
+	}
+	return notation.formatNumber(value) + " " + plural
+}
+
+// FormatTimePeriod formatiert eine Zeitspanne, z.B. "1 Jahr, 2 Monate und 3 Tage". Mit einer
+// Schreibweise werden die Zahlen z.B. als Zweierpotenz ("2¹⁴ Tage") oder hexadezimal
+// ("0x2000 Tage") geschrieben.
+func FormatTimePeriod(years, months, weeks, days int, notation ...Notation) string {
+	// Negative Werte beschreiben die Zeit bis zum Ankerdatum
+	if years < 0 || months < 0 || weeks < 0 || days < 0 {
+		return "noch " + FormatTimePeriod(abs(years), abs(months), abs(weeks), abs(days), notation...)
+	}
+
+	numbers := NotationDecimal
+	if len(notation) > 0 {
+		numbers = notation[0]
+	}
+
 	parts := []string{}
 
 	// Jahre hinzufügen, wenn vorhanden
 	if years > 0 {
-		if years == 1 {
-			parts = append(parts, "1 Jahr")
-		} else {
-			parts = append(parts, fmt.Sprintf("%d Jahre", years))
-		}
+		parts = append(parts, formatCount(years, "Jahr", "Jahre", numbers))
 	}
 
 	// Monate hinzufügen, wenn vorhanden
 	if months > 0 {
-		if months == 1 {
-			parts = append(parts, "1 Monat")
-		} else {
-			parts = append(parts, fmt.Sprintf("%d Monate", months))
-		}
+		parts = append(parts, formatCount(months, "Monat", "Monate", numbers))
 	}
 
 	// Wochen hinzufügen, wenn vorhanden
 	if weeks > 0 {
-		if weeks == 1 {
-			parts = append(parts, "1 Woche")
-		} else {
-			parts = append(parts, fmt.Sprintf("%d Wochen", weeks))
-		}
+		parts = append(parts, formatCount(weeks, "Woche", "Wochen", numbers))
 	}
 
 	// Tage hinzufügen, wenn vorhanden
 	if days > 0 {
-		if days == 1 {
-			parts = append(parts, "1 Tag")
-		} else {
-			parts = append(parts, fmt.Sprintf("%d Tage", days))
-		}
+		parts = append(parts, formatCount(days, "Tag", "Tage", numbers))
 	}
 
 	// Fall abfangen: Wenn alle Werte 0 sind
@@ -135,11 +416,25 @@ func FormatTimePeriod(years, months, weeks, days int) string {
 }
 
 func daysBetween(t1, t2 time.Time) int {
-	// Differenz in Nanosekunden
-	duration := t2.Sub(t1)
+	// Differenz der Kalendertage, unabhängig von Uhrzeit und Sommerzeit
+	return datecalc.DaysBetween(t1, t2)
+}
 
-	// Umrechnung in Tage (abgerundet)
-	return int(duration.Hours() / 24)
+// dateKey liefert das Kalenderdatum in der Zeitzone von t als sortierbaren String
+func dateKey(t time.Time) string {
+	return t.Format("2006-01-02")
+}
+
+// inLocationOf überträgt das Kalenderdatum von t in die Zeitzone von ref, damit etwa ein
+// Abfragedatum mit Ergebnissen in der Zeitzone des Geburtsdatums verglichen werden kann
+func inLocationOf(t, ref time.Time) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, 0, 0, 0, 0, ref.Location())
+}
+
+// DaysUntil liefert die Anzahl der Kalendertage vom Datum from bis zum Datum to
+func DaysUntil(from, to time.Time) int {
+	return daysBetween(inLocationOf(from, to), to)
 }
 
 func checkOverlapInCategories(exclude, list []string) bool {
@@ -172,8 +467,77 @@ func filterResultsAbove100(results []models.ResultEntry, birth time.Time, exclud
 	return filteredResults
 }
 
-// CalculateResults berechnet die Ergebnisdaten basierend auf den Zeitperioden und dem aktuellen Datum
-func CalculateResults(timePeriods []models.TimePeriod, birth time.Time, excludedCategories []string) []models.ResultEntry {
+// leapDayNotes erklärt bei Kindern, die am 29. Februar geboren sind, auf welchen Tag ein Jahres-
+// oder Monatstag fällt. skip gibt an, ob der Eintrag wegen der Regel "only" entfällt.
+func leapDayNotes(birth time.Time, years, months int, opts Options) ([]string, bool) {
+	anniversary, missing := datecalc.LeapDayAnniversary(birth, years, months)
+	if !anniversary {
+		return nil, false
+	}
+
+	if !missing {
+		if opts.LeapDay == datecalc.LeapDayOnly {
+			return []string{"Ein echter 29. Februar, den es nur in Schaltjahren gibt."}, false
+		}
+		return nil, false
+	}
+
+	if opts.LeapDay == datecalc.LeapDayOnly {
+		return nil, true
+	}
+	shifted := datecalc.AddYearsMonths(birth, years, months, opts.Policy)
+	return []string{fmt.Sprintf("Den 29. Februar gibt es %d nicht, stattdessen zählt der %s.", shifted.Year(), shifted.Format("02.01.2006"))}, false
+}
+
+// addPeriodValues addiert eine Periode [Jahr, Monat, Woche, Tag] nach den Regeln der Optionen
+func addPeriodValues(t time.Time, values [4]int, opts Options) time.Time {
+	return datecalc.AddPeriod(t, values[0], values[1], values[2], values[3], opts.Policy)
+}
+
+// periodEndDate liefert den letzten Tag eines mehrtägigen Eintrags. Ohne Ende oder bei einem
+// Ende, das nicht nach dem Beginn liegt, ist das Ergebnis der Nullwert (eintägiger Eintrag).
+func periodEndDate(period models.TimePeriod, anchor, start time.Time, opts Options) time.Time {
+	var end time.Time
+	switch {
+	case period.End != nil:
+		end = addPeriodValues(anchor, *period.End, opts)
+	case period.Duration != nil:
+		end = addPeriodValues(start, *period.Duration, opts)
+	default:
+		return time.Time{}
+	}
+	// Das Ende ist exklusiv, der letzte Tag liegt einen Tag davor. Ungültige Zeiträume werden
+	// beim Laden abgelehnt, je nach Monatslänge kann ein Zeitraum aber nur einen Tag umfassen.
+	end = end.AddDate(0, 0, -1)
+	if daysBetween(start, end) <= 0 {
+		return time.Time{}
+	}
+	return end
+}
+
+// periodResultID liefert die ID einer Zeitperiode aus ihren Werten. Einträge der Schwangerschaft
+// und mehrtägige Einträge bekommen ein eigenes Präfix, damit sie nicht mit den Meilensteinen ab
+// der Geburt zusammenfallen. Bei mehrtägigen Einträgen gehört auch das Ende zur ID.
+func periodResultID(period models.TimePeriod) string {
+	values := period.Values
+	id := fmt.Sprintf("%d-%d-%d-%d", values[0], values[1], values[2], values[3])
+	switch {
+	case period.End != nil:
+		end := *period.End
+		id = fmt.Sprintf("phase-%s-%d-%d-%d-%d", id, end[0], end[1], end[2], end[3])
+	case period.Duration != nil:
+		duration := *period.Duration
+		id = fmt.Sprintf("phase-%s-%d-%d-%d-%d", id, values[0]+duration[0], values[1]+duration[1], values[2]+duration[2], values[3]+duration[3])
+	}
+	if slices.Contains(period.Categories, categoryPregnancy) {
+		id = "pregnancy-" + id
+	}
+	return id
+}
+
+// calculatePeriodResults berechnet für jede Zeitperiode das Datum ab dem Ankerdatum anchor.
+// DaysBetween zählt immer ab der Geburt, damit Einträge verschiedener Anker sortierbar bleiben.
+func calculatePeriodResults(timePeriods []models.TimePeriod, anchor, birth time.Time, excludedCategories []string, opts Options) []models.ResultEntry {
 	var results []models.ResultEntry
 	for _, period := range timePeriods {
 		if checkOverlapInCategories(excludedCategories, period.Categories) {
@@ -187,28 +551,267 @@ func CalculateResults(timePeriods []models.TimePeriod, birth time.Time, excluded
 		week := values[2]
 		day := values[3]
 
-		// Addieren der Zeitwerte zum aktuellen Datum
-		resultDate := birth.
-			AddDate(year, month, day).
-			AddDate(0, 0, week*7) // Wochen in Tage umrechnen
+		notes, skip := leapDayNotes(anchor, year, month, opts)
+		if skip {
+			continue
+		}
+
+		// Addieren der Zeitwerte zum Ankerdatum
+		resultDate := datecalc.AddPeriod(anchor, year, month, week, day, opts.Policy)
+		endDate := periodEndDate(period, anchor, resultDate, opts)
+
+		formattedTimePeriod := period.Label
+		if formattedTimePeriod == "" {
+			anchorDay := year == 0 && month == 0 && week == 0 && day == 0
+			formattedTimePeriod = display.GetAnchorPeriod(opts.Anchor, FormatTimePeriod(year, month, week, day), anchorDay)
+		}
 
 		// Ergebnis speichern
 		result := models.ResultEntry{
 			OriginalValues:      period,
 			ResultDate:          resultDate,
 			FormattedDate:       resultDate.Format("02.01.2006"),
-			ResultId:            fmt.Sprintf("%d-%d-%d-%d", year, month, week, day),
-			FormattedTimePeriod: FormatTimePeriod(year, month, week, day),
+			ResultId:            periodResultID(period),
+			FormattedTimePeriod: formattedTimePeriod,
 			DaysBetween:         daysBetween(birth, resultDate),
 			Emoji:               period.Emoji,
 			Categories:          period.Categories,
+			Notes:               notes,
+			EndDate:             endDate,
 		}
 		results = append(results, result)
 	}
-	results = filterResultsAbove100(results, birth, excludedCategories)
+	return results
+}
 
-	sort.Slice(results, func(i, j int) bool {
-		return results[i].DaysBetween < results[j].DaysBetween
+// resultLastDate liefert den letzten Tag eines Ergebnisses, bei eintägigen Einträgen das Datum selbst
+func resultLastDate(result models.ResultEntry) time.Time {
+	if result.EndDate.IsZero() {
+		return result.ResultDate
+	}
+	return result.EndDate
+}
+
+// sortResults sortiert die Ergebnisse nach dem Abstand zur Geburt, Einträge vor der Geburt
+// (negativer Abstand) zuerst. Bei gleichem Beginn stehen eintägige und kürzere Einträge vor
+// längeren Zeiträumen. Die Sortierung ist stabil, damit bei gleichem Abstand das tatsächliche
+// Alter vor dem korrigierten steht.
+func sortResults(results []models.ResultEntry) {
+	sort.SliceStable(results, func(i, j int) bool {
+		if results[i].DaysBetween != results[j].DaysBetween {
+			return results[i].DaysBetween < results[j].DaysBetween
+		}
+		return resultLastDate(results[i]).Before(resultLastDate(results[j]))
 	})
+}
+
+// CalculateResults berechnet die Ergebnisdaten basierend auf den Zeitperioden und dem aktuellen Datum
+func CalculateResults(data DataSets, birth time.Time, excludedCategories []string, opts Options) []models.ResultEntry {
+	// Vor der Geburt gibt es nur die Einträge der Schwangerschaft und die Fristen für die Eltern
+	if opts.Unborn {
+		results := calculatePregnancyResults(data.TimePeriods, birth, excludedCategories, opts)
+		results = append(results, calculateParentalResults(birth, excludedCategories, opts)...)
+		setAnchor(results, opts.Anchor)
+		sortResults(results)
+		return results
+	}
+
+	// Die Schwangerschaft wird immer ab dem Termin gezählt und ist nicht Teil der Reihen ab der Geburt
+	birthExcludedCategories := append(slices.Clone(excludedCategories), categoryPregnancy)
+	// Bei Haustieren ersetzen die Hunde- bzw. Katzenjahre die Jahrestage
+	if opts.Species != "" && !slices.Contains(excludedCategories, categoryPetYears) {
+		birthExcludedCategories = append(birthExcludedCategories, "birthday")
+	}
+
+	var results []models.ResultEntry
+	if opts.showsActualAge(birth) {
+		results = append(results, calculatePeriodResults(data.TimePeriods, birth, birth, birthExcludedCategories, opts)...)
+	}
+	if opts.showsCorrectedAge(birth) {
+		results = append(results, calculateCorrectedResults(data.TimePeriods, birth, birthExcludedCategories, opts)...)
+	}
+	results = append(results, calculatePregnancyResults(data.TimePeriods, birth, excludedCategories, opts)...)
+	results = append(results, calculateCheckupResults(data.Checkups, birth, excludedCategories, opts)...)
+	results = append(results, calculateVaccinationResults(data.Vaccinations, birth, excludedCategories, opts)...)
+	results = append(results, calculateParentalResults(birth, excludedCategories, opts)...)
+	results = append(results, calculateSchoolResults(data.School, birth, excludedCategories, opts)...)
+	results = append(results, calculateLegalResults(data.Legal, birth, birthExcludedCategories, opts)...)
+	results = append(results, calculateNerdResults(birth, excludedCategories, opts)...)
+	results = append(results, calculatePlanetResults(birth, excludedCategories, opts)...)
+	results = append(results, calculateCalendarResults(birth, excludedCategories, opts)...)
+	results = append(results, calculateFirstsResults(birth, excludedCategories)...)
+	results = append(results, calculatePetResults(birth, excludedCategories, opts)...)
+	results = append(results, calculateFamilyResults(birth, excludedCategories, opts)...)
+	results = filterResultsAbove100(results, birth, excludedCategories)
+	// Die besonderen Kalenderdaten ergänzen die fertigen Ergebnisse
+	results = applyDatePatterns(results, birth, excludedCategories, opts)
+	setAnchor(results, opts.Anchor)
+
+	sortResults(results)
 	return results
 }
+
+// setAnchor setzt die Art des Ankerereignisses für die Beschreibung der Einträge
+func setAnchor(results []models.ResultEntry, anchor models.AnchorType) {
+	for i := range results {
+		results[i].Anchor = anchor
+	}
+}
+
+// SplitFeedResults teilt die sortierten Ergebnisse für Feeds auf: die letzten maxRecent
+// Einträge bis einschließlich now (neueste zuerst) und die nächsten maxUpcoming Einträge danach.
+func SplitFeedResults(results []models.ResultEntry, now time.Time, maxRecent, maxUpcoming int) ([]models.ResultEntry, []models.ResultEntry) {
+	// Der aktuelle Zeitpunkt zählt als Datum in der Zeitzone der Ergebnisse
+	if len(results) > 0 {
+		now = now.In(results[0].ResultDate.Location())
+	}
+	today := dateKey(now)
+
+	recent := make([]models.ResultEntry, 0, maxRecent)
+	upcoming := make([]models.ResultEntry, 0, maxUpcoming)
+	for _, result := range results {
+		if dateKey(result.ResultDate) <= today {
+			recent = append(recent, result)
+		} else if len(upcoming) < maxUpcoming {
+			upcoming = append(upcoming, result)
+		}
+	}
+
+	// Nur die neuesten Einträge behalten, absteigend sortiert
+	if len(recent) > maxRecent {
+		recent = recent[len(recent)-maxRecent:]
+	}
+	slices.Reverse(recent)
+
+	return recent, upcoming
+}
+
+// UpcomingResults liefert die nächsten limit Ergebnisse ab dem Datum from (einschließlich)
+func UpcomingResults(results []models.ResultEntry, from time.Time, limit int) []models.ResultEntry {
+	fromKey := dateKey(from)
+
+	upcoming := make([]models.ResultEntry, 0, limit)
+	for _, result := range results {
+		if len(upcoming) >= limit {
+			break
+		}
+		if dateKey(result.ResultDate) >= fromKey {
+			upcoming = append(upcoming, result)
+		}
+	}
+	return upcoming
+}
+
+// ResultsOnDate liefert alle Ergebnisse, die auf das angegebene Datum fallen
+func ResultsOnDate(results []models.ResultEntry, date time.Time) []models.ResultEntry {
+	key := dateKey(date)
+
+	matches := make([]models.ResultEntry, 0)
+	for _, result := range results {
+		if dateKey(result.ResultDate) == key {
+			matches = append(matches, result)
+		}
+	}
+	return matches
+}
+
+// AdjacentResults liefert den letzten Meilenstein bis einschließlich date und den ersten danach
+func AdjacentResults(results []models.ResultEntry, date time.Time) (*models.ResultEntry, *models.ResultEntry) {
+	key := dateKey(date)
+
+	var previous, next *models.ResultEntry
+	for i := range results {
+		if dateKey(results[i].ResultDate) <= key {
+			previous = &results[i]
+		} else if next == nil {
+			next = &results[i]
+		}
+	}
+	return previous, next
+}
+
+// CalculateAge berechnet das Alter am Datum at mit derselben Arithmetik wie CalculateResults:
+// erst volle Jahre und Monate nach der Monatsende-Regel, der Rest in Wochen und Tagen
+func CalculateAge(birth, at time.Time, opts Options) models.Age {
+	at = inLocationOf(at, birth)
+	totalMonths := (at.Year()-birth.Year())*12 + int(at.Month()-birth.Month())
+	for totalMonths > 0 && datecalc.AddYearsMonths(birth, 0, totalMonths, opts.Policy).After(at) {
+		totalMonths--
+	}
+
+	remainingDays := daysBetween(datecalc.AddYearsMonths(birth, 0, totalMonths, opts.Policy), at)
+	totalDays := daysBetween(birth, at)
+	years := totalMonths / 12
+	months := totalMonths % 12
+	weeks := remainingDays / 7
+	days := remainingDays % 7
+
+	return models.Age{
+		Years:       years,
+		Months:      months,
+		Weeks:       weeks,
+		Days:        days,
+		TotalDays:   totalDays,
+		TotalWeeks:  totalDays / 7,
+		TotalMonths: totalMonths,
+		TotalHours:  totalDays * 24,
+		Formatted:   FormatTimePeriod(years, months, weeks, days),
+		Planets:     calculatePlanetAges(birth, at, opts),
+	}
+}
+
+// LookupResults sucht für mehrere Personen alle Meilensteine zwischen from und to (jeweils einschließlich)
+// und gruppiert sie nach Datum. Tage, an denen mehrere Personen einen Meilenstein haben, werden markiert.
+// Eltern haben keine eigenen Meilensteine, sondern nur solche im Verhältnis zu den Kindern.
+func LookupResults(data DataSets, persons []models.Person, from, to time.Time, excludedCategories []string, opts Options) []models.LookupDay {
+	// Die Meilensteine zwischen den Personen werden hier für alle gemeinsam berechnet
+	opts.Name = ""
+	opts.Relatives = nil
+
+	fromKey := dateKey(from)
+	toKey := dateKey(to)
+
+	byDate := map[string]*models.LookupDay{}
+	add := func(lookupResult models.LookupResult) {
+		key := dateKey(lookupResult.Result.ResultDate)
+		if key < fromKey || key > toKey {
+			return
+		}
+		day, ok := byDate[key]
+		if !ok {
+			day = &models.LookupDay{Date: lookupResult.Result.ResultDate}
+			byDate[key] = day
+		}
+		day.Results = append(day.Results, lookupResult)
+	}
+	for index, person := range persons {
+		if IsParent(person) {
+			continue
+		}
+		for _, result := range CalculateResults(data, person.Birth, excludedCategories, opts) {
+			add(models.LookupResult{Person: person, PersonIndex: index, Result: result})
+		}
+	}
+	for _, lookupResult := range calculateRelationResults(persons, excludedCategories, opts) {
+		add(lookupResult)
+	}
+
+	days := make([]models.LookupDay, 0, len(byDate))
+	for _, day := range byDate {
+		// Namen können doppelt sein, gezählt werden deshalb die Positionen. Einträge mehrerer
+		// Personen wie das gemeinsame Alter zählen nicht als eigene Person.
+		indices := map[int]bool{}
+		for _, lookupResult := range day.Results {
+			if lookupResult.PersonIndex >= 0 {
+				indices[lookupResult.PersonIndex] = true
+			}
+		}
+		day.Shared = len(indices) > 1
+		days = append(days, *day)
+	}
+
+	sort.Slice(days, func(i, j int) bool {
+		return days[i].Date.Before(days[j].Date)
+	})
+	return days
+}
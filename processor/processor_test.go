@@ -0,0 +1,96 @@
+package processor
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+// loadDataSets lädt die Datensätze aus dem Verzeichnis data wie beim Start des Servers
+func loadDataSets(t *testing.T) DataSets {
+	t.Helper()
+	timePeriods, err := LoadTimePeriods("../data/periods.json")
+	if err != nil {
+		t.Fatalf("LoadTimePeriods: %v", err)
+	}
+	checkups, err := LoadCheckups("../data/checkups.json")
+	if err != nil {
+		t.Fatalf("LoadCheckups: %v", err)
+	}
+	vaccinations, err := LoadVaccinations("../data/vaccinations.json")
+	if err != nil {
+		t.Fatalf("LoadVaccinations: %v", err)
+	}
+	school, err := LoadSchoolRules("../data/school.json")
+	if err != nil {
+		t.Fatalf("LoadSchoolRules: %v", err)
+	}
+	legal, err := LoadLegalRules("../data/legal.json")
+	if err != nil {
+		t.Fatalf("LoadLegalRules: %v", err)
+	}
+	return DataSets{TimePeriods: timePeriods, Checkups: checkups, Vaccinations: vaccinations, School: school, Legal: legal}
+}
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestCalculateResultsUniqueIDs(t *testing.T) {
+	data := loadDataSets(t)
+	birth := date(2023, time.January, 1)
+	opts := Options{
+		Due:                 date(2023, time.February, 10),
+		AgeMode:             AgeModeBoth,
+		CheckupReminderDays: 14,
+		State:               "BY",
+		Country:             "DE",
+		Planets:             []string{"mars"},
+		Calendars:           []string{"hebrew"},
+	}
+
+	// Ohne ausgeschlossene Kategorien erscheinen alle Einträge, auch die Entwicklungsphasen
+	results := CalculateResults(data, birth, nil, opts)
+	ids := map[string]bool{}
+	for _, result := range results {
+		if ids[result.ResultId] {
+			t.Errorf("duplicate result ID %q (%s)", result.ResultId, result.FormattedTimePeriod)
+		}
+		ids[result.ResultId] = true
+	}
+	for _, id := range []string{"phase-0-8-0-0-0-10-0-0", "corrected-phase-2-0-0-0-4-0-0-0", "2-0-0-0", "0-8-0-0"} {
+		if !ids[id] {
+			t.Errorf("missing result ID %q", id)
+		}
+	}
+}
+
+func TestParseTimePeriodsRejectsDuplicateIDs(t *testing.T) {
+	tests := map[string]string{
+		"same values":       `[[0, 0, 0, 100, []], [0, 0, 0, 100, ["other"]]]`,
+		"generator overlap": `[{"generate": "sequence", "unit": "days", "from": 100, "to": 300, "step": 100, "categories": []}, [0, 0, 0, 200, []]]`,
+		"same span":         `[[0, 8, 0, 0, ["phases"], "🙈", "A", {"end": [0, 10, 0, 0]}], [0, 8, 0, 0, ["phases"], "🙈", "B", {"duration": [0, 2, 0, 0]}]]`,
+	}
+	for name, input := range tests {
+		t.Run(name, func(t *testing.T) {
+			var rawData []json.RawMessage
+			if err := json.Unmarshal([]byte(input), &rawData); err != nil {
+				t.Fatal(err)
+			}
+			_, err := parseTimePeriods(rawData, periodResultID)
+			if err == nil || !strings.Contains(err.Error(), "doppelt") {
+				t.Errorf("parseTimePeriods(%s) error = %v, want duplicate ID", input, err)
+			}
+		})
+	}
+
+	// Ein Zeitraum und ein Meilenstein mit demselben Beginn sind verschiedene Einträge
+	var rawData []json.RawMessage
+	if err := json.Unmarshal([]byte(`[[2, 0, 0, 0, []], [2, 0, 0, 0, ["phases"], "😤", "Phase", {"end": [4, 0, 0, 0]}]]`), &rawData); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parseTimePeriods(rawData, periodResultID); err != nil {
+		t.Errorf("parseTimePeriods: %v", err)
+	}
+}
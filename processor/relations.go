@@ -0,0 +1,264 @@
+package processor
+
+import (
+	"baby-calendar/display"
+	"baby-calendar/models"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Kategorie der Meilensteine zwischen mehreren Personen
+const categoryRelations = "relations"
+
+// Rollen einer Person in der Familie. Ohne Rolle ist eine Person ein Kind.
+const (
+	RoleGirl   = "girl"
+	RoleBoy    = "boy"
+	RoleMother = "mother"
+	RoleFather = "father"
+	RoleParent = "parent"
+)
+
+var roles = []string{RoleGirl, RoleBoy, RoleMother, RoleFather, RoleParent}
+
+// Bezeichnung älterer Geschwister und der Eltern nach Rolle
+var siblingNouns = map[string]string{RoleGirl: "große Schwester", RoleBoy: "großer Bruder", "": "großes Geschwisterkind"}
+var parentNouns = map[string]string{RoleMother: "Mutter", RoleFather: "Vater", RoleParent: "Elternteil"}
+
+// Dauer als großes Geschwisterkind oder Elternteil, für die ein Eintrag erzeugt wird
+var relationYears = []int{1, 5, 10, 18, 20, 25, 30, 40, 50}
+var relationDays = []int{100, 1000, 5000, 10000}
+
+// Größtes Verhältnis zweier Alter, für das ein Eintrag erzeugt wird
+const maxAgeRatio = 5
+
+// Abstand der Einträge zum gemeinsamen Alter in Jahren
+const combinedAgeStep = 5
+
+// Durchschnittliche Länge eines Jahres im gregorianischen Kalender
+const daysPerYear = 365.2425
+
+// Meilensteine zwischen Personen werden bis zu diesem Alter der jüngeren Person berechnet
+const maxRelationAgeYears = 100
+
+// ValidateRole prüft die Rolle einer Person
+func ValidateRole(role string) error {
+	if role == "" || slices.Contains(roles, role) {
+		return nil
+	}
+	return fmt.Errorf("Invalid role %q. Use one of %s.", role, strings.Join(roles, ", "))
+}
+
+// IsParent gibt an, ob die Person ein Elternteil ist. Eltern haben keine eigenen Meilensteine,
+// sondern nur solche im Verhältnis zu den Kindern.
+func IsParent(person models.Person) bool {
+	_, ok := parentNouns[person.Role]
+	return ok
+}
+
+// ceilDiv teilt positive Zahlen und rundet auf
+func ceilDiv(a, b int) int {
+	return (a + b - 1) / b
+}
+
+// calculateRelationResults berechnet die Meilensteine zwischen mehreren Personen: wann eine
+// Person doppelt (bis fünfmal) so alt ist wie eine jüngere, das gemeinsame Alter aller Kinder
+// und wie lange ältere Geschwister und Eltern es schon sind
+func calculateRelationResults(persons []models.Person, excludedCategories []string, opts Options) []models.LookupResult {
+	if len(persons) < 2 || slices.Contains(excludedCategories, categoryRelations) {
+		return nil
+	}
+
+	newResult := func(subject models.Person, subjectIndex int, id, label, emoji string, date time.Time, notes []string) models.LookupResult {
+		return models.LookupResult{
+			Person:      subject,
+			PersonIndex: subjectIndex,
+			Result: models.ResultEntry{
+				OriginalValues: models.TimePeriod{
+					Categories: []string{categoryRelations},
+					Emoji:      emoji,
+				},
+				ResultDate:          date,
+				FormattedDate:       date.Format("02.01.2006"),
+				ResultId:            "relation-" + id,
+				FormattedTimePeriod: label,
+				DaysBetween:         daysBetween(subject.Birth, date),
+				Emoji:               emoji,
+				Categories:          []string{categoryRelations},
+				Notes:               notes,
+			},
+		}
+	}
+
+	// Personen ohne Namen werden nach ihrer Position benannt
+	persons = slices.Clone(persons)
+	for i := range persons {
+		if persons[i].Name == "" {
+			persons[i].Name = fmt.Sprintf("Person %d", i+1)
+		}
+	}
+
+	var children []models.Person
+	for _, person := range persons {
+		if !IsParent(person) {
+			children = append(children, person)
+		}
+	}
+	slices.SortStableFunc(children, func(a, b models.Person) int {
+		return a.Birth.Compare(b.Birth)
+	})
+
+	var results []models.LookupResult
+	// Namen können mehrfach vorkommen, die IDs enthalten deshalb die Positionen beider Personen
+	for olderIndex, older := range persons {
+		for youngerIndex, younger := range persons {
+			gap := daysBetween(older.Birth, younger.Birth)
+			if gap <= 0 || (IsParent(older) && IsParent(younger)) || IsParent(younger) {
+				continue
+			}
+
+			// Die ältere Person ist k-mal so alt, sobald die jüngere gap/(k-1) Tage alt ist
+			for ratio := 2; ratio <= maxAgeRatio; ratio++ {
+				youngerDays := ceilDiv(gap, ratio-1)
+				date := younger.Birth.AddDate(0, 0, youngerDays)
+				notes := []string{display.GetRatioDescription(older.Name, gap+youngerDays, younger.Name, youngerDays)}
+				results = append(results, newResult(older, olderIndex, fmt.Sprintf("ratio-%d-%d-%d", ratio, olderIndex+1, youngerIndex+1), display.GetRatioLabel(ratio, younger.Name), "⚖️", date, notes))
+			}
+
+			if IsParent(older) {
+				continue
+			}
+			noun := siblingNouns[older.Role]
+			for _, date := range relationDates(younger.Birth, opts) {
+				label := fmt.Sprintf("%s %s von %s", date.since, noun, younger.Name)
+				results = append(results, newResult(older, olderIndex, fmt.Sprintf("sibling-%s-%d-%d", date.id, olderIndex+1, youngerIndex+1), label, "👫", date.date, nil))
+			}
+		}
+	}
+
+	// Eltern sind es seit der Geburt des ältesten Kindes
+	if len(children) > 0 {
+		eldest := children[0]
+		for parentIndex, parent := range persons {
+			if !IsParent(parent) {
+				continue
+			}
+			for _, date := range relationDates(eldest.Birth, opts) {
+				label := fmt.Sprintf("%s %s", date.since, parentNouns[parent.Role])
+				notes := []string{fmt.Sprintf("Gerechnet ab der Geburt von %s am %s.", eldest.Name, eldest.Birth.Format("02.01.2006"))}
+				results = append(results, newResult(parent, parentIndex, fmt.Sprintf("parent-%s-%d", date.id, parentIndex+1), label, "👪", date.date, notes))
+			}
+		}
+	}
+
+	return append(results, combinedAgeResults(children, newResult)...)
+}
+
+// relationDate ist ein Jahres- oder Tagestag seit einem Ereignis
+type relationDate struct {
+	id    string
+	since string
+	date  time.Time
+}
+
+// relationDates liefert die Tage, an denen ein Ereignis die Dauern aus relationYears und
+// relationDays zurückliegt, nach Datum sortiert
+func relationDates(start time.Time, opts Options) []relationDate {
+	var dates []relationDate
+	for _, years := range relationYears {
+		dates = append(dates, relationDate{
+			id:    fmt.Sprintf("%d-years", years),
+			since: display.GetSince(years, 0),
+			date:  addPeriodValues(start, [4]int{years, 0, 0, 0}, opts),
+		})
+	}
+	for _, days := range relationDays {
+		dates = append(dates, relationDate{
+			id:    fmt.Sprintf("%d-days", days),
+			since: display.GetSince(0, days),
+			date:  start.AddDate(0, 0, days),
+		})
+	}
+	slices.SortStableFunc(dates, func(a, b relationDate) int {
+		return a.date.Compare(b.date)
+	})
+	return dates
+}
+
+// combinedAgeResults berechnet, wann die Kinder zusammen ein Vielfaches von combinedAgeStep
+// Jahren alt sind. Gezählt wird erst, wenn alle Kinder geboren sind.
+func combinedAgeResults(children []models.Person, newResult func(models.Person, int, string, string, string, time.Time, []string) models.LookupResult) []models.LookupResult {
+	if len(children) < 2 {
+		return nil
+	}
+
+	youngest := children[len(children)-1]
+	names := make([]string, 0, len(children))
+	// Gemeinsames Alter aller Kinder am Tag der Geburt des jüngsten
+	ageAtYoungestBirth := 0
+	for _, child := range children {
+		names = append(names, child.Name)
+		ageAtYoungestBirth += daysBetween(child.Birth, youngest.Birth)
+	}
+	group := models.Person{Name: display.JoinNames(names), Birth: youngest.Birth}
+
+	limit := youngest.Birth.AddDate(maxRelationAgeYears, 0, 0)
+	var results []models.LookupResult
+	for years := combinedAgeStep; ; years += combinedAgeStep {
+		remaining := int(float64(years)*daysPerYear+0.5) - ageAtYoungestBirth
+		if remaining < 0 {
+			continue
+		}
+		date := youngest.Birth.AddDate(0, 0, ceilDiv(remaining, len(children)))
+		if date.After(limit) {
+			break
+		}
+		totalDays := ageAtYoungestBirth + daysBetween(youngest.Birth, date)*len(children)
+		result := newResult(group, -1, fmt.Sprintf("combined-%d", years), fmt.Sprintf("zusammen %d Jahre alt", years), "➕", date, nil)
+		result.Result.Description = display.GetCombinedAgeDescription(names, years, totalDays)
+		results = append(results, result)
+	}
+	return results
+}
+
+// calculateFamilyResults berechnet für den Kalender einer Person die Meilensteine zu den
+// weiteren Personen aus opts.Relatives: die Einträge, in denen sie selbst die ältere Person ist,
+// und das gemeinsame Alter aller Kinder
+func calculateFamilyResults(birth time.Time, excludedCategories []string, opts Options) []models.ResultEntry {
+	if len(opts.Relatives) == 0 {
+		return nil
+	}
+	self := models.Person{Name: opts.Name, Birth: birth}
+	if self.Name == "" {
+		self.Name = "Kind"
+	}
+	persons := append([]models.Person{self}, opts.Relatives...)
+
+	var others []string
+	for i, person := range opts.Relatives {
+		if !IsParent(person) {
+			if person.Name == "" {
+				person.Name = fmt.Sprintf("Person %d", i+2)
+			}
+			others = append(others, person.Name)
+		}
+	}
+
+	var results []models.ResultEntry
+	for _, lookupResult := range calculateRelationResults(persons, excludedCategories, opts) {
+		result := lookupResult.Result
+		switch lookupResult.PersonIndex {
+		case 0:
+		case -1:
+			// Das gemeinsame Alter wird aus Sicht der Person des Kalenders beschrieben
+			result.FormattedTimePeriod = fmt.Sprintf("mit %s %s", display.JoinNames(others), result.FormattedTimePeriod)
+		default:
+			continue
+		}
+		result.DaysBetween = daysBetween(birth, result.ResultDate)
+		results = append(results, result)
+	}
+	return results
+}
@@ -0,0 +1,124 @@
+package processor
+
+import (
+	"baby-calendar/models"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestCalculateRelationResults(t *testing.T) {
+	persons := []models.Person{
+		{Name: "Ida", Birth: date(2022, time.March, 4), Role: RoleGirl},
+		{Name: "Emil", Birth: date(2025, time.April, 21), Role: RoleBoy},
+		{Name: "Mama", Birth: date(1990, time.May, 1), Role: RoleMother},
+	}
+	results := calculateRelationResults(persons, nil, Options{})
+
+	byID := map[string]models.LookupResult{}
+	for _, result := range results {
+		if _, ok := byID[result.Result.ResultId]; ok {
+			t.Errorf("duplicate result ID %q", result.Result.ResultId)
+		}
+		byID[result.Result.ResultId] = result
+	}
+
+	tests := []struct {
+		id    string
+		index int
+		label string
+		date  time.Time
+	}{
+		// Ida ist 1144 Tage älter und damit doppelt so alt, wenn Emil 1144 Tage alt ist
+		{"relation-ratio-2-1-2", 0, "jetzt doppelt so alt wie Emil", date(2028, time.June, 8)},
+		{"relation-sibling-1-years-1-2", 0, "seit einem Jahr große Schwester von Emil", date(2026, time.April, 21)},
+		{"relation-parent-1-years-3", 2, "seit einem Jahr Mutter", date(2023, time.March, 4)},
+		{"relation-combined-5", -1, "zusammen 5 Jahre alt", date(2026, time.March, 28)},
+	}
+	for _, tt := range tests {
+		result, ok := byID[tt.id]
+		if !ok {
+			t.Errorf("missing %s", tt.id)
+			continue
+		}
+		if result.PersonIndex != tt.index || result.Result.FormattedTimePeriod != tt.label || !result.Result.ResultDate.Equal(tt.date) {
+			t.Errorf("%s = %d %q on %s, want %d %q on %s", tt.id, result.PersonIndex, result.Result.FormattedTimePeriod, result.Result.ResultDate.Format("2006-01-02"), tt.index, tt.label, tt.date.Format("2006-01-02"))
+		}
+	}
+
+	// Eltern sind nie die jüngere Person
+	if _, ok := byID["relation-ratio-2-1-3"]; ok {
+		t.Error("ratio against a parent")
+	}
+	if results := calculateRelationResults(persons, []string{categoryRelations}, Options{}); results != nil {
+		t.Errorf("excluded relations category returned %d results", len(results))
+	}
+}
+
+func TestCalculateFamilyResults(t *testing.T) {
+	birth := date(2022, time.March, 4)
+	opts := Options{
+		Name:      "Ida",
+		Relatives: []models.Person{{Name: "Emil", Birth: date(2025, time.April, 21), Role: RoleBoy}},
+	}
+	results := calculateFamilyResults(birth, nil, opts)
+	if len(results) == 0 {
+		t.Fatal("no results")
+	}
+	found := map[string]models.ResultEntry{}
+	for _, result := range results {
+		found[result.ResultId] = result
+		if result.Categories[0] != categoryRelations {
+			t.Errorf("%s has categories %v", result.ResultId, result.Categories)
+		}
+		if want := daysBetween(birth, result.ResultDate); result.DaysBetween != want {
+			t.Errorf("%s DaysBetween = %d, want %d", result.ResultId, result.DaysBetween, want)
+		}
+	}
+	if _, ok := found["relation-ratio-2-1-2"]; !ok {
+		t.Error("missing relation-ratio-2-1-2")
+	}
+	if got := found["relation-combined-5"].FormattedTimePeriod; got != "mit Emil zusammen 5 Jahre alt" {
+		t.Errorf("combined label = %q", got)
+	}
+
+	// Im Kalender des jüngeren Kindes gibt es nur das gemeinsame Alter
+	opts = Options{Name: "Emil", Relatives: []models.Person{{Name: "Ida", Birth: birth}}}
+	for _, result := range calculateFamilyResults(date(2025, time.April, 21), nil, opts) {
+		if !strings.HasPrefix(result.ResultId, "relation-combined-") {
+			t.Errorf("younger child got %s", result.ResultId)
+		}
+	}
+
+	if results := calculateFamilyResults(birth, nil, Options{}); results != nil {
+		t.Errorf("without relatives got %d results", len(results))
+	}
+}
+
+func TestLookupResultsShared(t *testing.T) {
+	data := loadDataSets(t)
+	// Zwillinge mit demselben Namen haben jeden Meilenstein am selben Tag
+	birth := date(2025, time.April, 21)
+	persons := []models.Person{{Name: "Kind", Birth: birth}, {Name: "Kind", Birth: birth}}
+	days := LookupResults(data, persons, date(2025, time.July, 1), date(2025, time.December, 31), nil, Options{})
+	if len(days) == 0 {
+		t.Fatal("no days")
+	}
+	for _, day := range days {
+		if !day.Shared {
+			t.Errorf("%s not shared for twins with the same name", day.Date.Format("2006-01-02"))
+		}
+	}
+
+	// Das gemeinsame Alter allein macht einen Tag nicht zum gemeinsamen Tag
+	persons = []models.Person{{Name: "Ida", Birth: date(2022, time.March, 4)}, {Name: "Emil", Birth: birth}}
+	days = LookupResults(data, persons, date(2026, time.March, 28), date(2026, time.March, 28), nil, Options{})
+	if len(days) != 1 {
+		t.Fatalf("got %d days, want the combined age day", len(days))
+	}
+	for _, day := range days {
+		if day.Shared {
+			t.Errorf("%s shared with %d results", day.Date.Format("2006-01-02"), len(day.Results))
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package processor
+
+import (
+	"baby-calendar/display"
+	"baby-calendar/models"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"time"
+)
+
+// Kategorie der Einträge zu Kita und Schule
+const categorySchool = "school"
+
+// Alter, ab dem Kinder zum Stichtag schulpflichtig werden
+const schoolAge = 6
+
+// parseMonthDay liest ein Datum ohne Jahr im Format MM-DD
+func parseMonthDay(value string) (time.Month, int, error) {
+	date, err := time.Parse("01-02", value)
+	if err != nil {
+		return 0, 0, err
+	}
+	return date.Month(), date.Day(), nil
+}
+
+// LoadSchoolRules lädt die Regeln für Kita und Schule aus der JSON-Datei
+func LoadSchoolRules(filePath string) (models.SchoolRules, error) {
+	byteValue, err := os.ReadFile(filePath)
+	if err != nil {
+		return models.SchoolRules{}, fmt.Errorf("Fehler beim Lesen der Datei: %w", err)
+	}
+
+	var rules models.SchoolRules
+	if err := json.Unmarshal(byteValue, &rules); err != nil {
+		return models.SchoolRules{}, fmt.Errorf("Fehler beim Unmarshalling des JSON: %w", err)
+	}
+
+	for code, state := range rules.States {
+		if _, _, err := parseMonthDay(state.Cutoff); err != nil {
+			return models.SchoolRules{}, fmt.Errorf("Fehler bei %s: ungültiger Stichtag %q", code, state.Cutoff)
+		}
+		if _, _, err := parseMonthDay(state.SchoolYearStart); err != nil {
+			return models.SchoolRules{}, fmt.Errorf("Fehler bei %s: ungültiger Schuljahresbeginn %q", code, state.SchoolYearStart)
+		}
+		if state.Grades < 1 {
+			return models.SchoolRules{}, fmt.Errorf("Fehler bei %s: Anzahl der Klassen fehlt", code)
+		}
+	}
+	return rules, nil
+}
+
+// ValidateState prüft, ob es für das Kürzel eines Bundeslands Regeln gibt
+func ValidateState(rules models.SchoolRules, state string) error {
+	if _, ok := rules.States[state]; ok {
+		return nil
+	}
+	codes := make([]string, 0, len(rules.States))
+	for code := range rules.States {
+		codes = append(codes, code)
+	}
+	slices.Sort(codes)
+	return fmt.Errorf("Invalid state value %q. Use one of %s.", state, strings.Join(codes, ", "))
+}
+
+// schoolEnrollmentYear berechnet das Jahr der Einschulung: Wer bis zum Stichtag sechs Jahre alt
+// wird, kommt im selben Jahr in die Schule, sonst ein Jahr später
+func schoolEnrollmentYear(birth time.Time, state models.StateSchool, opts Options) int {
+	month, day, _ := parseMonthDay(state.Cutoff)
+	sixthBirthday := addPeriodValues(birth, [4]int{schoolAge, 0, 0, 0}, opts)
+	cutoff := time.Date(sixthBirthday.Year(), month, day, 0, 0, 0, 0, birth.Location())
+	if sixthBirthday.After(cutoff) {
+		return sixthBirthday.Year() + 1
+	}
+	return sixthBirthday.Year()
+}
+
+// calculateSchoolResults berechnet die Rechtsansprüche auf einen Betreuungsplatz und für das
+// Bundesland in den Optionen die Einschulung und den Beginn jedes weiteren Schuljahrs
+func calculateSchoolResults(rules models.SchoolRules, birth time.Time, excludedCategories []string, opts Options) []models.ResultEntry {
+	state, ok := rules.States[opts.State]
+	if !ok || slices.Contains(excludedCategories, categorySchool) {
+		return nil
+	}
+
+	newEntry := func(id, label, emoji string, date time.Time, description string, values [4]int) models.ResultEntry {
+		return models.ResultEntry{
+			OriginalValues: models.TimePeriod{
+				Values:     values,
+				Categories: []string{categorySchool},
+				Emoji:      emoji,
+			},
+			ResultDate:          date,
+			FormattedDate:       date.Format("02.01.2006"),
+			ResultId:            "school-" + id,
+			FormattedTimePeriod: label,
+			DaysBetween:         daysBetween(birth, date),
+			Emoji:               emoji,
+			Categories:          []string{categorySchool},
+			Description:         description,
+		}
+	}
+
+	var results []models.ResultEntry
+	for _, claim := range rules.KitaClaims {
+		date := addPeriodValues(birth, claim.Age, opts)
+		description := display.GetSourcedDescription(fmt.Sprintf("Ab heute besteht bundesweit ein %s.", claim.Label), claim.Source)
+		results = append(results, newEntry(claim.ID, claim.Label, "🧸", date, description, claim.Age))
+	}
+
+	enrollmentYear := schoolEnrollmentYear(birth, state, opts)
+	cutoffMonth, cutoffDay, _ := parseMonthDay(state.Cutoff)
+	startMonth, startDay, _ := parseMonthDay(state.SchoolYearStart)
+	for grade := 1; grade <= state.Grades; grade++ {
+		year := enrollmentYear + grade - 1
+		date := time.Date(year, startMonth, startDay, 0, 0, 0, 0, birth.Location())
+
+		label := fmt.Sprintf("Beginn der %d. Klasse", grade)
+		emoji := "📚"
+		if grade == 1 {
+			label = "Einschulung"
+			emoji = "🎒"
+		}
+		description := display.GetSchoolDescription(state.Name, grade, year, cutoffDay, cutoffMonth, enrollmentYear, state.Note, rules.Version)
+		results = append(results, newEntry(fmt.Sprintf("grade-%d", grade), label, emoji, date, description, [4]int{}))
+	}
+	return results
+}
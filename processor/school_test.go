@@ -0,0 +1,68 @@
+package processor
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSchoolEnrollmentYear(t *testing.T) {
+	rules, err := LoadSchoolRules("../data/school.json")
+	if err != nil {
+		t.Fatalf("LoadSchoolRules: %v", err)
+	}
+
+	tests := []struct {
+		state string
+		birth time.Time
+		want  int
+	}{
+		// Stichtag 30. Juni: wer bis dahin sechs wird, kommt im selben Jahr in die Schule
+		{"BW", date(2019, time.June, 30), 2025},
+		{"BW", date(2019, time.July, 1), 2026},
+		{"HB", date(2019, time.June, 30), 2025},
+		{"HB", date(2019, time.July, 1), 2026},
+		// Stichtag 30. September
+		{"BY", date(2019, time.September, 30), 2025},
+		{"BY", date(2019, time.October, 1), 2026},
+		{"BE", date(2019, time.July, 1), 2025},
+	}
+	for _, tt := range tests {
+		if got := schoolEnrollmentYear(tt.birth, rules.States[tt.state], Options{}); got != tt.want {
+			t.Errorf("schoolEnrollmentYear(%s, %s) = %d, want %d", tt.birth.Format("2006-01-02"), tt.state, got, tt.want)
+		}
+	}
+}
+
+func TestCalculateSchoolResults(t *testing.T) {
+	rules, err := LoadSchoolRules("../data/school.json")
+	if err != nil {
+		t.Fatalf("LoadSchoolRules: %v", err)
+	}
+
+	results := calculateSchoolResults(rules, date(2019, time.July, 1), nil, Options{State: "BW"})
+	want := map[string]time.Time{
+		"school-krippe":       date(2020, time.July, 1),
+		"school-kindergarten": date(2022, time.July, 1),
+		"school-grade-1":      date(2026, time.August, 1),
+		"school-grade-13":     date(2038, time.August, 1),
+	}
+	found := 0
+	for _, result := range results {
+		if wantDate, ok := want[result.ResultId]; ok {
+			found++
+			if !result.ResultDate.Equal(wantDate) {
+				t.Errorf("%s = %s, want %s", result.ResultId, result.ResultDate.Format("2006-01-02"), wantDate.Format("2006-01-02"))
+			}
+		}
+	}
+	if found != len(want) {
+		t.Errorf("found %d of %d expected results", found, len(want))
+	}
+	if len(results) != 2+13 {
+		t.Errorf("got %d results, want 15", len(results))
+	}
+
+	if results := calculateSchoolResults(rules, date(2019, time.July, 1), nil, Options{}); results != nil {
+		t.Errorf("without state got %d results, want none", len(results))
+	}
+}
@@ -0,0 +1,66 @@
+package processor
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+)
+
+func TestMultiDayPeriods(t *testing.T) {
+	raw := []json.RawMessage{
+		json.RawMessage(`[0, 8, 0, 0, ["phases"], "🙈", "Fremdelphase", {"duration": [0, 2, 0, 0]}]`),
+		json.RawMessage(`[2, 0, 0, 0, ["phases"], "😤", "Autonomiephase", {"end": [4, 0, 0, 0]}]`),
+		json.RawMessage(`[0, 0, 0, 10, [], "📅", "Zwei Tage", {"duration": [0, 0, 0, 2]}]`),
+		json.RawMessage(`[0, 0, 0, 10, []]`),
+	}
+	periods, err := parseTimePeriods(raw, periodResultID)
+	if err != nil {
+		t.Fatalf("parseTimePeriods: %v", err)
+	}
+
+	birth := date(2023, time.January, 31)
+	results := calculatePeriodResults(periods, birth, birth, nil, Options{})
+	if len(results) != len(periods) {
+		t.Fatalf("got %d results, want %d", len(results), len(periods))
+	}
+	tests := []struct {
+		id         string
+		start, end time.Time
+	}{
+		// Die Dauer zählt ab dem Beginn, das Ende ist exklusiv
+		{"phase-0-8-0-0-0-10-0-0", date(2023, time.October, 1), date(2023, time.November, 30)},
+		{"phase-2-0-0-0-4-0-0-0", date(2025, time.January, 31), date(2027, time.January, 30)},
+		// Ein Zeitraum teilt nicht die ID des Eintrags am selben Tag
+		{"phase-0-0-0-10-0-0-0-12", date(2023, time.February, 10), date(2023, time.February, 11)},
+		{"0-0-0-10", date(2023, time.February, 10), time.Time{}},
+	}
+	for _, tt := range tests {
+		var found bool
+		for _, result := range results {
+			if result.ResultId != tt.id {
+				continue
+			}
+			found = true
+			if !result.ResultDate.Equal(tt.start) || !result.EndDate.Equal(tt.end) {
+				t.Errorf("%s = %s–%s, want %s–%s", tt.id, result.ResultDate.Format("2006-01-02"), result.EndDate.Format("2006-01-02"), tt.start.Format("2006-01-02"), tt.end.Format("2006-01-02"))
+			}
+		}
+		if !found {
+			t.Errorf("missing %s", tt.id)
+		}
+	}
+}
+
+func TestMultiDayPeriodErrors(t *testing.T) {
+	tests := []string{
+		`[2, 0, 0, 0, ["phases"], "😤", "Rückwärts", {"end": [1, 0, 0, 0]}]`,
+		`[2, 0, 0, 0, ["phases"], "😤", "Beides", {"end": [4, 0, 0, 0], "duration": [1, 0, 0, 0]}]`,
+		`[2, 0, 0, 0, ["phases"], "😤", "Leer", {}]`,
+		`[0, 0, 0, 10, [], "📅", "Ein Tag", {"duration": [0, 0, 0, 1]}]`,
+	}
+	for _, entry := range tests {
+		if _, err := parseTimePeriods([]json.RawMessage{json.RawMessage(entry)}, periodResultID); err == nil {
+			t.Errorf("%s accepted", entry)
+		}
+	}
+}
@@ -0,0 +1,150 @@
+package processor
+
+import (
+	"baby-calendar/display"
+	"baby-calendar/models"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Kategorie der Impftermine nach den Empfehlungen der STIKO
+const categoryVaccinations = "vaccinations"
+
+// doseKey liefert den Schlüssel einer Impfdosis, z.B. "6fach-1"
+func doseKey(id string, dose int) string {
+	return fmt.Sprintf("%s-%d", id, dose)
+}
+
+// ParseGivenDose liest eine gegebene Impfdosis im Format "<Impfstoff>-<Dosis>:YYYY-MM-DD"
+// (z.B. "6fach-1:2025-04-03") und liefert ihren Schlüssel und das Datum in der Zeitzone loc.
+// Impfstoff und Dosis müssen im Impfkalender vorkommen.
+func ParseGivenDose(vaccinations []models.Vaccination, value string, loc *time.Location) (string, time.Time, error) {
+	invalid := fmt.Errorf("Invalid vaccinated value %q. Use <vaccine>-<dose>:YYYY-MM-DD, e.g. 6fach-1:2025-04-03.", value)
+
+	key, dateValue, ok := strings.Cut(value, ":")
+	if !ok {
+		return "", time.Time{}, invalid
+	}
+	separator := strings.LastIndex(key, "-")
+	if separator <= 0 {
+		return "", time.Time{}, invalid
+	}
+	dose, err := strconv.Atoi(key[separator+1:])
+	if err != nil || dose < 1 {
+		return "", time.Time{}, invalid
+	}
+	date, err := time.ParseInLocation("2006-01-02", dateValue, loc)
+	if err != nil {
+		return "", time.Time{}, invalid
+	}
+	key = doseKey(key[:separator], dose)
+
+	keys := make([]string, 0, len(vaccinations))
+	for _, vaccination := range vaccinations {
+		keys = append(keys, doseKey(vaccination.ID, vaccination.Dose))
+	}
+	if !slices.Contains(keys, key) {
+		return "", time.Time{}, fmt.Errorf("Unknown vaccine dose %q in vaccinated value %q. Use one of %s.", key, value, strings.Join(keys, ", "))
+	}
+	return key, date, nil
+}
+
+// LoadVaccinations lädt den Impfkalender aus der JSON-Datei
+func LoadVaccinations(filePath string) ([]models.Vaccination, error) {
+	byteValue, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("Fehler beim Lesen der Datei: %w", err)
+	}
+
+	var vaccinations []models.Vaccination
+	if err := json.Unmarshal(byteValue, &vaccinations); err != nil {
+		return nil, fmt.Errorf("Fehler beim Unmarshalling des JSON: %w", err)
+	}
+
+	for index, vaccination := range vaccinations {
+		if vaccination.ID == "" || vaccination.Dose < 1 {
+			return nil, fmt.Errorf("Fehler in Eintrag %d: Impfstoff oder Dosis fehlt", index+1)
+		}
+		if hasMixedSigns(vaccination.Age) || hasMixedSigns(vaccination.MinInterval) {
+			return nil, fmt.Errorf("Fehler in Eintrag %d: Werte von %s haben unterschiedliche Vorzeichen", index+1, doseKey(vaccination.ID, vaccination.Dose))
+		}
+	}
+
+	// Der Mindestabstand bezieht sich auf die vorherige Dosis, deshalb müssen die Dosen
+	// eines Impfstoffs aufsteigend berechnet werden
+	slices.SortStableFunc(vaccinations, func(a, b models.Vaccination) int {
+		return a.Dose - b.Dose
+	})
+	return vaccinations, nil
+}
+
+// calculateVaccinationResults berechnet die Impftermine ab der Geburt. Eine Dosis liegt am
+// empfohlenen Alter, frühestens aber nach dem Mindestabstand zur vorherigen Dosis. Bereits
+// gegebene Dosen stehen am tatsächlichen Datum und verschieben so die folgenden Dosen.
+func calculateVaccinationResults(vaccinations []models.Vaccination, birth time.Time, excludedCategories []string, opts Options) []models.ResultEntry {
+	if slices.Contains(excludedCategories, categoryVaccinations) {
+		return nil
+	}
+
+	doses := map[string]int{}
+	for _, vaccination := range vaccinations {
+		doses[vaccination.ID]++
+	}
+
+	previous := map[string]time.Time{}
+	previousDose := map[string]int{}
+
+	var results []models.ResultEntry
+	for _, vaccination := range vaccinations {
+		key := doseKey(vaccination.ID, vaccination.Dose)
+		recommended := addPeriodValues(birth, vaccination.Age, opts)
+		recommendedAge := FormatTimePeriod(vaccination.Age[0], vaccination.Age[1], vaccination.Age[2], vaccination.Age[3])
+
+		var date time.Time
+		var description string
+		if given, ok := opts.GivenDoses[key]; ok {
+			date = inLocationOf(given, birth)
+			description = display.GetGivenVaccinationDescription(vaccination.Diseases, date)
+		} else {
+			date = recommended
+			shifted := false
+			if last, ok := previous[vaccination.ID]; ok {
+				if earliest := addPeriodValues(last, vaccination.MinInterval, opts); earliest.After(date) {
+					date = earliest
+					shifted = true
+				}
+			}
+			minInterval := FormatTimePeriod(vaccination.MinInterval[0], vaccination.MinInterval[1], vaccination.MinInterval[2], vaccination.MinInterval[3])
+			description = display.GetVaccinationDescription(vaccination.Diseases, recommendedAge, recommended, shifted, minInterval, previousDose[vaccination.ID])
+		}
+		previous[vaccination.ID] = date
+		previousDose[vaccination.ID] = vaccination.Dose
+
+		formattedTimePeriod := fmt.Sprintf("Impfung %s", vaccination.Name)
+		if doses[vaccination.ID] > 1 {
+			formattedTimePeriod = fmt.Sprintf("Impfung %s (%d. Dosis)", vaccination.Name, vaccination.Dose)
+		}
+
+		results = append(results, models.ResultEntry{
+			OriginalValues: models.TimePeriod{
+				Values:     vaccination.Age,
+				Categories: []string{categoryVaccinations},
+				Emoji:      "💉",
+			},
+			ResultDate:          date,
+			FormattedDate:       date.Format("02.01.2006"),
+			ResultId:            "vaccination-" + key,
+			FormattedTimePeriod: formattedTimePeriod,
+			DaysBetween:         daysBetween(birth, date),
+			Emoji:               "💉",
+			Categories:          []string{categoryVaccinations},
+			Description:         description,
+		})
+	}
+	return results
+}
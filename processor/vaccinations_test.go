@@ -0,0 +1,86 @@
+package processor
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseGivenDose(t *testing.T) {
+	vaccinations, err := LoadVaccinations("../data/vaccinations.json")
+	if err != nil {
+		t.Fatalf("LoadVaccinations: %v", err)
+	}
+
+	key, given, err := ParseGivenDose(vaccinations, "6fach-1:2025-04-03", time.UTC)
+	if err != nil || key != "6fach-1" || !given.Equal(date(2025, time.April, 3)) {
+		t.Errorf("ParseGivenDose = %q, %s, %v", key, given.Format("2006-01-02"), err)
+	}
+
+	tests := []struct {
+		value string
+		want  string
+	}{
+		{"6fach-1", "Invalid vaccinated value"},
+		{"6fach:2025-04-03", "Invalid vaccinated value"},
+		{"6fach-0:2025-04-03", "Invalid vaccinated value"},
+		{"6fach-1:03.04.2025", "Invalid vaccinated value"},
+		{"6fach-9:2025-04-03", "Unknown vaccine dose \"6fach-9\""},
+		{"grippe-1:2025-04-03", "Unknown vaccine dose"},
+	}
+	for _, tt := range tests {
+		if _, _, err := ParseGivenDose(vaccinations, tt.value, time.UTC); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("ParseGivenDose(%q) error = %v, want %q", tt.value, err, tt.want)
+		}
+	}
+}
+
+func TestCalculateVaccinationResults(t *testing.T) {
+	vaccinations, err := LoadVaccinations("../data/vaccinations.json")
+	if err != nil {
+		t.Fatalf("LoadVaccinations: %v", err)
+	}
+	birth := date(2025, time.January, 15)
+
+	dates := func(opts Options) map[string]time.Time {
+		dates := map[string]time.Time{}
+		for _, result := range calculateVaccinationResults(vaccinations, birth, nil, opts) {
+			if _, ok := dates[result.ResultId]; ok {
+				t.Errorf("duplicate result ID %q", result.ResultId)
+			}
+			dates[result.ResultId] = result.ResultDate
+		}
+		return dates
+	}
+
+	tests := []struct {
+		name string
+		opts Options
+		want map[string]time.Time
+	}{
+		{"recommended", Options{}, map[string]time.Time{
+			"vaccination-6fach-1": date(2025, time.March, 15),
+			"vaccination-6fach-2": date(2025, time.May, 15),
+			"vaccination-6fach-3": date(2025, time.December, 15),
+		}},
+		// Eine später gegebene erste Dosis verschiebt die zweite um den Mindestabstand von 8 Wochen,
+		// die dritte liegt weiter am empfohlenen Alter
+		{"given dose", Options{GivenDoses: map[string]time.Time{"6fach-1": date(2025, time.April, 10)}}, map[string]time.Time{
+			"vaccination-6fach-1": date(2025, time.April, 10),
+			"vaccination-6fach-2": date(2025, time.June, 5),
+			"vaccination-6fach-3": date(2025, time.December, 15),
+		}},
+	}
+	for _, tt := range tests {
+		got := dates(tt.opts)
+		for id, want := range tt.want {
+			if !got[id].Equal(want) {
+				t.Errorf("%s: %s = %s, want %s", tt.name, id, got[id].Format("2006-01-02"), want.Format("2006-01-02"))
+			}
+		}
+	}
+
+	if results := calculateVaccinationResults(vaccinations, birth, []string{categoryVaccinations}, Options{}); results != nil {
+		t.Errorf("excluded vaccinations category returned %d results", len(results))
+	}
+}
@@ -0,0 +1,190 @@
+package main
+
+import (
+	"baby-calendar/output"
+	"baby-calendar/processor"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultUpcomingLimit = 5
+const maxUpcomingLimit = 100
+
+// getLocation liest die Zeitzone aus dem Parameter tz (z.B. Europe/Berlin), Standard ist UTC
+func getLocation(query url.Values) (*time.Location, error) {
+	value := query.Get("tz")
+	if value == "" {
+		return time.UTC, nil
+	}
+	loc, err := time.LoadLocation(value)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid tz value %q. Use an IANA time zone like Europe/Berlin.", value)
+	}
+	return loc, nil
+}
+
+// getLocationFingerprint liefert die Zeitzone für den Cache-Dateinamen, bei UTC nichts
+func getLocationFingerprint(loc *time.Location) []string {
+	if loc == time.UTC {
+		return nil
+	}
+	return []string{"tz-" + strings.ReplaceAll(loc.String(), "/", "-")}
+}
+
+// parseDateParam liest ein Datum im Format YYYY-MM-DD in der Zeitzone loc aus der Query.
+// Fehlt der Parameter, wird fallback zurückgegeben.
+func parseDateParam(query url.Values, key string, loc *time.Location, fallback time.Time) (time.Time, error) {
+	value := query.Get(key)
+	if value == "" {
+		return fallback, nil
+	}
+	parsed, err := time.ParseInLocation("2006-01-02", value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("Invalid %s date format. Use YYYY-MM-DD.", key)
+	}
+	return parsed, nil
+}
+
+// today liefert das heutige Datum in der Zeitzone loc ohne Uhrzeit, passend zu den
+// geparsten Datumsparametern
+func today(loc *time.Location) time.Time {
+	now := time.Now().In(loc)
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+}
+
+// parseQueryRequest liest die gemeinsamen Parameter der Abfrage-Endpunkte: das Geburtsdatum,
+// das Abfragedatum aus dem Parameter dateKey und die Einstellungen für die Berechnung
+func parseQueryRequest(w http.ResponseWriter, r *http.Request, dateKey string) (url.Values, time.Time, time.Time, processor.Options, bool) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return nil, time.Time{}, time.Time{}, processor.Options{}, false
+	}
+
+	query := r.URL.Query()
+	loc, err := getLocation(query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, time.Time{}, time.Time{}, processor.Options{}, false
+	}
+	birth, err := parseDateParam(query, "birth", loc, time.Time{})
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, time.Time{}, time.Time{}, processor.Options{}, false
+	}
+	date, err := parseDateParam(query, dateKey, loc, today(loc))
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, time.Time{}, time.Time{}, processor.Options{}, false
+	}
+	opts, err := getOptions(query)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return nil, time.Time{}, time.Time{}, processor.Options{}, false
+	}
+	if birth.IsZero() {
+		if opts.Due.IsZero() {
+			http.Error(w, "Missing birth parameter.", http.StatusBadRequest)
+			return nil, time.Time{}, time.Time{}, processor.Options{}, false
+		}
+		// Vor der Geburt wird ab dem Termin gerechnet
+		birth = opts.Due
+		opts.Unborn = true
+	}
+	return query, birth, date, opts, true
+}
+
+func writeJSON(w http.ResponseWriter, data interface{}) {
+	responseData, err := json.MarshalIndent(data, "", "  ")
+	if err != nil {
+		http.Error(w, "Error generating JSON response", http.StatusInternalServerError)
+		return
+	}
+	output.SetContentTypeByFormat(w, "json")
+	w.Write(responseData)
+}
+
+func writeText(w http.ResponseWriter, text string) {
+	output.SetContentTypeByFormat(w, "text")
+	w.Write([]byte(text))
+}
+
+// handleUpcomingRequest liefert die nächsten Meilensteine ab einem Datum (Standard: heute)
+func handleUpcomingRequest(w http.ResponseWriter, r *http.Request) {
+	query, birth, date, opts, ok := parseQueryRequest(w, r, "date")
+	if !ok {
+		return
+	}
+
+	limit := defaultUpcomingLimit
+	if query.Get("limit") != "" {
+		parsedLimit, err := strconv.Atoi(query.Get("limit"))
+		if err != nil || parsedLimit < 1 || parsedLimit > maxUpcomingLimit {
+			http.Error(w, fmt.Sprintf("Invalid limit. Use a number between 1 and %d.", maxUpcomingLimit), http.StatusBadRequest)
+			return
+		}
+		limit = parsedLimit
+	}
+
+	cleanName := getCleanName(query)
+	includeEmoji := query.Has("emoji")
+
+	results := processor.CalculateResults(dataSets, birth, getExcludedCategories(query), opts)
+	upcoming := processor.UpcomingResults(results, date, limit)
+
+	if query.Get("format") == "text" {
+		writeText(w, output.GenerateUpcomingText(date, upcoming, cleanName, includeEmoji))
+		return
+	}
+	writeJSON(w, output.GenerateUpcomingJSON(birth, date, upcoming, cleanName, includeEmoji))
+}
+
+// handleTodayRequest liefert die Meilensteine an einem Datum (Standard: heute) und den nächsten danach
+func handleTodayRequest(w http.ResponseWriter, r *http.Request) {
+	query, birth, date, opts, ok := parseQueryRequest(w, r, "date")
+	if !ok {
+		return
+	}
+
+	cleanName := getCleanName(query)
+	includeEmoji := query.Has("emoji")
+
+	results := processor.CalculateResults(dataSets, birth, getExcludedCategories(query), opts)
+	matches := processor.ResultsOnDate(results, date)
+	upcoming := processor.UpcomingResults(results, date.AddDate(0, 0, 1), 1)
+
+	if query.Get("format") == "text" {
+		writeText(w, output.GenerateTodayText(date, matches, upcoming, cleanName, includeEmoji))
+		return
+	}
+	writeJSON(w, output.GenerateTodayJSON(birth, date, matches, upcoming, cleanName, includeEmoji))
+}
+
+// handleAgeRequest liefert das genaue Alter an einem Datum (Standard: heute)
+func handleAgeRequest(w http.ResponseWriter, r *http.Request) {
+	query, birth, at, opts, ok := parseQueryRequest(w, r, "at")
+	if !ok {
+		return
+	}
+	if at.Before(birth) {
+		http.Error(w, "The at date must not be before the birth date.", http.StatusBadRequest)
+		return
+	}
+
+	cleanName := getCleanName(query)
+	includeEmoji := query.Has("emoji")
+
+	age := processor.CalculateAge(birth, at, opts)
+	results := processor.CalculateResults(dataSets, birth, getExcludedCategories(query), opts)
+	previous, next := processor.AdjacentResults(results, at)
+
+	if query.Get("format") == "text" {
+		writeText(w, output.GenerateAgeText(at, age, previous, next, cleanName, includeEmoji))
+		return
+	}
+	writeJSON(w, output.GenerateAgeJSON(birth, at, age, previous, next, cleanName, includeEmoji))
+}
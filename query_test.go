@@ -0,0 +1,105 @@
+package main
+
+import (
+	"baby-calendar/models"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestMain(m *testing.M) {
+	var err error
+	dataSets, err = loadDataSets()
+	if err != nil {
+		panic(err)
+	}
+	os.Exit(m.Run())
+}
+
+// request ruft einen Handler mit der URL target auf
+func request(handler http.HandlerFunc, target string) *httptest.ResponseRecorder {
+	recorder := httptest.NewRecorder()
+	handler(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+	return recorder
+}
+
+// decodeJSON liest die JSON-Antwort eines Handlers
+func decodeJSON(t *testing.T, recorder *httptest.ResponseRecorder, v any) {
+	t.Helper()
+	if recorder.Code != http.StatusOK {
+		t.Fatalf("status %d: %s", recorder.Code, recorder.Body.String())
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), v); err != nil {
+		t.Fatalf("invalid JSON: %v", err)
+	}
+}
+
+func TestHandleUpcomingRequest(t *testing.T) {
+	var upcoming models.UpcomingJSON
+	decodeJSON(t, request(handleUpcomingRequest, "/upcoming?birth=2025-04-21&date=2025-07-29&limit=3&name=Emil"), &upcoming)
+	if upcoming.Date != "2025-07-29" || upcoming.Name != "Emil" || len(upcoming.Results) != 3 {
+		t.Fatalf("got %+v", upcoming)
+	}
+	// Der 100. Tag ist am nächsten Tag
+	first := upcoming.Results[0]
+	if first.ResultDate != "2025-07-30" || first.FormattedTimePeriod != "100 Tage" || first.DaysRemaining != 1 {
+		t.Errorf("first upcoming = %+v", first)
+	}
+	for i := 1; i < len(upcoming.Results); i++ {
+		if upcoming.Results[i].ResultDate < upcoming.Results[i-1].ResultDate {
+			t.Errorf("results not sorted: %s after %s", upcoming.Results[i].ResultDate, upcoming.Results[i-1].ResultDate)
+		}
+	}
+
+	text := request(handleUpcomingRequest, "/upcoming?birth=2025-04-21&date=2025-07-29&limit=1&format=text")
+	if !strings.Contains(text.Body.String(), "100 Tage") || !strings.HasPrefix(text.Header().Get("Content-Type"), "text/plain") {
+		t.Errorf("text response = %q", text.Body.String())
+	}
+}
+
+func TestHandleTodayRequest(t *testing.T) {
+	var today models.TodayJSON
+	decodeJSON(t, request(handleTodayRequest, "/today?birth=2025-04-21&date=2025-07-30"), &today)
+	if !today.IsSpecial || len(today.Results) == 0 || today.Results[0].FormattedTimePeriod != "100 Tage" {
+		t.Errorf("got %+v", today)
+	}
+	if today.Next == nil || today.Next.ResultDate <= "2025-07-30" {
+		t.Errorf("next = %+v", today.Next)
+	}
+
+	today = models.TodayJSON{}
+	decodeJSON(t, request(handleTodayRequest, "/today?birth=2025-04-21&date=2025-07-31"), &today)
+	if today.IsSpecial || len(today.Results) != 0 || today.Next == nil {
+		t.Errorf("ordinary day = %+v", today)
+	}
+}
+
+func TestQueryRequestErrors(t *testing.T) {
+	tests := []struct {
+		handler http.HandlerFunc
+		target  string
+		want    string
+	}{
+		{handleUpcomingRequest, "/upcoming", "Missing birth parameter."},
+		{handleUpcomingRequest, "/upcoming?birth=21.04.2025", "Invalid birth date format."},
+		{handleUpcomingRequest, "/upcoming?birth=2025-04-21&limit=101", "Invalid limit."},
+		{handleUpcomingRequest, "/upcoming?birth=2025-04-21&tz=Mars/Olympus", "Invalid tz value"},
+		{handleTodayRequest, "/today?birth=2025-04-21&date=gestern", "Invalid date date format."},
+		{handleTodayRequest, "/today?birth=2025-04-21&month-end=deadline", "Invalid month-end value"},
+	}
+	for _, tt := range tests {
+		recorder := request(tt.handler, tt.target)
+		if recorder.Code != http.StatusBadRequest || !strings.Contains(recorder.Body.String(), tt.want) {
+			t.Errorf("%s = %d %q, want 400 %q", tt.target, recorder.Code, recorder.Body.String(), tt.want)
+		}
+	}
+
+	recorder := httptest.NewRecorder()
+	handleTodayRequest(recorder, httptest.NewRequest(http.MethodPost, "/today?birth=2025-04-21", nil))
+	if recorder.Code != http.StatusMethodNotAllowed {
+		t.Errorf("POST status = %d", recorder.Code)
+	}
+}